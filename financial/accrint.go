@@ -0,0 +1,34 @@
+package financial
+
+// Accrint returns the accrued interest of a security that pays periodic interest, like Excel ACCRINT.
+// Interest is accrued by the (quasi) coupon periods of firstInterest, so odd first periods are handled like Excel.
+// When calcMethod is true (Excel default), the interest is accrued from the issue, otherwise (and the settlement is
+// after the first interest) from the last coupon date.
+// Frequency: 1=yearly, 2=half yearly, 4=quarterly.
+// Basis: 0=USA (NASD) 30/360, 1=exact/exact, 2=exact/360, 3=exact/365, 4=Europe 30/360
+func Accrint(issue Date, firstInterest Date, settlement Date, rate float64, par float64, frequency int64, basis int64, calcMethod bool) (float64, error) {
+	if rate <= 0 || par <= 0 || !validFrequency(frequency) || !validBasis(basis) || !issue.Date.Before(settlement.Date) {
+		return 0, ErrParametersError
+	}
+
+	aFirst := newScaDate(firstInterest, basis)
+	aSettle := newScaDate(settlement, basis)
+	aStart := newScaDate(issue, basis)
+	if !calcMethod && firstInterest.Date.Before(settlement.Date) {
+		aStart = getCouppcd(aSettle, aFirst, frequency)
+	}
+
+	return par * rate / float64(frequency) * getCouponFraction(aStart, aSettle, aFirst, frequency, basis), nil
+}
+
+// Accrintm returns the accrued interest of a security that pays interest at maturity, like Excel ACCRINTM.
+func Accrintm(issue Date, settlement Date, rate float64, par float64, basis int64) (float64, error) {
+	if rate <= 0 || par <= 0 || !issue.Date.Before(settlement.Date) {
+		return 0, ErrParametersError
+	}
+	fYearFrac, e := YearFrac(issue, settlement, basis)
+	if e != nil {
+		return 0, e
+	}
+	return par * rate * fYearFrac, nil
+}
@@ -0,0 +1,65 @@
+package financial
+
+import (
+	"github.com/aviplot/go-finance-math/test/testdata"
+	"testing"
+)
+
+// TestAccrint validate ACCRINT function
+func TestAccrint(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetAccrintTestData()
+
+	for _, td := range tdTab {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Accrint(NewDateFromFormattedString(td.Issue), NewDateFromFormattedString(td.FirstInterest), NewDateFromFormattedString(td.Settlement),
+			td.Rate, td.Par, td.Frequency, td.Basis, td.CalcMethod)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	issue := NewDateFromFormattedString("2008-03-01")
+	firstInterest := NewDateFromFormattedString("2008-08-31")
+	if _, e := Accrint(issue, firstInterest, issue, 0.1, 1000, 2, 0, true); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Accrint(issue, firstInterest, firstInterest, 0.1, 1000, 3, 0, true); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestAccrintm validate ACCRINTM function
+func TestAccrintm(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetAccrintmTestData()
+
+	for _, td := range tdTab {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Accrintm(NewDateFromFormattedString(td.Issue), NewDateFromFormattedString(td.Settlement), td.Rate, td.Par, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	issue := NewDateFromFormattedString("2008-04-01")
+	settlement := NewDateFromFormattedString("2008-06-15")
+	if _, e := Accrintm(settlement, issue, 0.1, 1000, 3); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Accrintm(issue, settlement, 0.1, 1000, 7); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
@@ -34,3 +34,130 @@ func GetZw(fZins, fZzr, fRmz, fBw float64, nF int64) float64 {
 
 	return -fZw
 }
+
+// isLeapYear returns true for leap year (gregorian calendar).
+func isLeapYear(nYear int) bool {
+	return (nYear%4 == 0 && nYear%100 != 0) || nYear%400 == 0
+}
+
+// isFebruaryEnd returns true for the last day of February.
+func isFebruaryEnd(nYear, nMonth, nDay int) bool {
+	if isLeapYear(nYear) {
+		return nMonth == 2 && nDay == 29
+	}
+	return nMonth == 2 && nDay == 28
+}
+
+// YearFrac returns the fraction of the year between two dates, like Excel YEARFRAC.
+// Basis: 0=USA (NASD) 30/360, 1=exact/exact, 2=exact/360, 3=exact/365, 4=Europe 30/360
+func YearFrac(start Date, end Date, basis int64) (float64, error) {
+	if basis < 0 || basis > 4 {
+		return 0, ErrParametersError
+	}
+	if start.Date.Equal(end.Date) {
+		return 0, nil
+	}
+	if start.Date.After(end.Date) {
+		start, end = end, start
+	}
+
+	nYear1, m1, nDay1 := start.Date.Date()
+	nYear2, m2, nDay2 := end.Date.Date()
+	nMonth1, nMonth2 := int(m1), int(m2)
+
+	// Days between the dates
+	var nDayDiff int
+	switch basis {
+	case 0: // USA (NASD) 30/360
+		if nDay1 == 31 {
+			nDay1--
+		}
+		if nDay1 == 30 && nDay2 == 31 {
+			nDay2--
+		} else if isFebruaryEnd(nYear1, nMonth1, nDay1) {
+			nDay1 = 30
+			if isFebruaryEnd(nYear2, nMonth2, nDay2) {
+				nDay2 = 30
+			}
+		}
+		nDayDiff = (nYear2-nYear1)*360 + (nMonth2-nMonth1)*30 + (nDay2 - nDay1)
+	case 4: // Europe 30/360
+		if nDay1 == 31 {
+			nDay1--
+		}
+		if nDay2 == 31 {
+			nDay2--
+		}
+		nDayDiff = (nYear2-nYear1)*360 + (nMonth2-nMonth1)*30 + (nDay2 - nDay1)
+	default: // exact
+		nDayDiff = int(end.DaysFrom(start))
+	}
+
+	// Days in year
+	var fDaysInYear float64
+	switch basis {
+	case 0, 2, 4:
+		fDaysInYear = 360
+	case 3:
+		fDaysInYear = 365
+	case 1:
+		isYearDifferent := nYear1 != nYear2
+		if isYearDifferent && (nYear2 != nYear1+1 || nMonth1 < nMonth2 || (nMonth1 == nMonth2 && nDay1 < nDay2)) {
+			// Average of days in the years between the dates, inclusive
+			nDayCount := 0
+			for i := nYear1; i <= nYear2; i++ {
+				if isLeapYear(i) {
+					nDayCount += 366
+				} else {
+					nDayCount += 365
+				}
+			}
+			fDaysInYear = float64(nDayCount) / float64(nYear2-nYear1+1)
+		} else if (!isYearDifferent && isLeapYear(nYear1)) ||
+			(isYearDifferent && isLeapYear(nYear1) && (nMonth1 < 2 || (nMonth1 == 2 && nDay1 <= 29))) ||
+			(isYearDifferent && isLeapYear(nYear2) && (nMonth2 > 2 || (nMonth2 == 2 && nDay2 == 29))) {
+			// February 29 is between the dates
+			fDaysInYear = 366
+		} else {
+			fDaysInYear = 365
+		}
+	}
+
+	return float64(nDayDiff) / fDaysInYear, nil
+}
+
+// daysInMonth returns the number of days in the month of the year.
+func daysInMonth(nMonth, nYear int) int {
+	switch nMonth {
+	case 2:
+		if isLeapYear(nYear) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	default:
+		return 31
+	}
+}
+
+// getDaysInYears returns the number of days in the years between nYear1 and nYear2, inclusive.
+func getDaysInYears(nYear1, nYear2 int) int {
+	nLeaps := 0
+	for i := nYear1; i <= nYear2; i++ {
+		if isLeapYear(i) {
+			nLeaps++
+		}
+	}
+	return (nYear2-nYear1+1)*365 + nLeaps
+}
+
+// validBasis returns true for the supported day count bases (0-4).
+func validBasis(nBase int64) bool {
+	return nBase >= 0 && nBase <= 4
+}
+
+// validFrequency returns true for yearly, half yearly or quarterly coupons.
+func validFrequency(nFreq int64) bool {
+	return nFreq == 1 || nFreq == 2 || nFreq == 4
+}
@@ -0,0 +1,107 @@
+package financial
+
+import (
+	"math"
+)
+
+// getPrice is PRICE calculation, parameters are valid.
+func getPrice(nSettle, nMat Date, fRate, fYield, fRedemp float64, nFreq, nBase int64) float64 {
+	fFreq := float64(nFreq)
+
+	fE := getCoupdays(nSettle, nMat, nFreq, nBase)
+	fN := getCoupnum(nSettle, nMat, nFreq, nBase)
+	fA := getCoupdaybs(nSettle, nMat, nFreq, nBase)
+
+	if fN == 1 {
+		// One coupon until the redemption (like Excel), simple interest discounting
+		fDSR := float64(scaDateDiff(newScaDate(nSettle, nBase), newScaDate(nMat, nBase)))
+		return (fRedemp+100*fRate/fFreq)/(1+fDSR/fE*fYield/fFreq) - 100*fRate/fFreq*fA/fE
+	}
+
+	fDSC_E := getCoupdaysnc(nSettle, nMat, nFreq, nBase) / fE
+
+	fRet := fRedemp / math.Pow(1+fYield/fFreq, fN-1+fDSC_E)
+	fRet -= 100 * fRate / fFreq * fA / fE
+
+	fT1 := 100 * fRate / fFreq
+	fT2 := 1 + fYield/fFreq
+
+	for fK := 0.0; fK < fN; fK++ {
+		fRet += fT1 / math.Pow(fT2, fK+fDSC_E)
+	}
+	return fRet
+}
+
+// Price returns the price per 100 face value of a security that pays periodic interest, like Excel PRICE.
+// Frequency: 1=yearly, 2=half yearly, 4=quarterly.
+// Basis: 0=USA (NASD) 30/360, 1=exact/exact, 2=exact/360, 3=exact/365, 4=Europe 30/360
+func Price(settlement Date, maturity Date, rate float64, yld float64, redemption float64, frequency int64, basis int64) (float64, error) {
+	if yld < 0 || rate < 0 || redemption <= 0 || !validCoupon(settlement, maturity, frequency, basis) {
+		return 0, ErrParametersError
+	}
+	return getPrice(settlement, maturity, rate, yld, redemption, frequency, basis), nil
+}
+
+// Yield returns the yield of a security that pays periodic interest, like Excel YIELD.
+// Solved iteratively (secant), with the price per 100 face value.
+func Yield(settlement Date, maturity Date, rate float64, pr float64, redemption float64, frequency int64, basis int64) (float64, error) {
+	if rate < 0 || pr <= 0 || redemption <= 0 || !validCoupon(settlement, maturity, frequency, basis) {
+		return 0, ErrParametersError
+	}
+
+	if getCoupnum(settlement, maturity, frequency, basis) == 1 {
+		// One coupon until the redemption (like Excel), direct calculation
+		fFreq := float64(frequency)
+		fE := getCoupdays(settlement, maturity, frequency, basis)
+		fA := getCoupdaybs(settlement, maturity, frequency, basis)
+		fDSR := float64(scaDateDiff(newScaDate(settlement, basis), newScaDate(maturity, basis)))
+		fPar := pr/100 + fA/fE*rate/fFreq
+		return (redemption/100 + rate/fFreq - fPar) / fPar * fFreq * fE / fDSR, nil
+	}
+
+	return solveYield(func(fYield float64) float64 {
+		return getPrice(settlement, maturity, rate, fYield, redemption, frequency, basis)
+	}, pr)
+}
+
+// solveYield returns the yield that getPriceOf returns fPrice for (like getYield_ of open office).
+func solveYield(getPriceOf func(float64) float64, fPrice float64) (float64, error) {
+	var fPriceN float64
+	fYield1 := 0.0
+	fYield2 := 1.0
+	fPrice1 := getPriceOf(fYield1)
+	fPrice2 := getPriceOf(fYield2)
+	fYieldN := (fYield2 - fYield1) * 0.5
+
+	for nIter := 0; nIter < 100 && fPriceN != fPrice; nIter++ {
+		fPriceN = getPriceOf(fYieldN)
+
+		switch {
+		case fPrice == fPrice1:
+			return fYield1, nil
+		case fPrice == fPrice2:
+			return fYield2, nil
+		case fPrice == fPriceN:
+			return fYieldN, nil
+		case fPrice < fPrice2:
+			fYield2 *= 2
+			fPrice2 = getPriceOf(fYield2)
+			fYieldN = (fYield2 - fYield1) * 0.5
+		default:
+			if fPrice < fPriceN {
+				fYield1 = fYieldN
+				fPrice1 = fPriceN
+			} else {
+				fYield2 = fYieldN
+				fPrice2 = fPriceN
+			}
+			fYieldN = fYield2 - (fYield2-fYield1)*((fPrice-fPrice2)/(fPrice1-fPrice2))
+		}
+	}
+
+	if math.Abs(fPrice-fPriceN) > fPrice/100 {
+		// Result is not precise enough
+		return 0, ErrCalculationError
+	}
+	return fYieldN, nil
+}
@@ -0,0 +1,64 @@
+package financial
+
+import (
+	"github.com/aviplot/go-finance-math/test/testdata"
+	"testing"
+)
+
+// TestPrice validate PRICE function
+func TestPrice(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetBondTestData()
+
+	for _, td := range tdTab {
+		expected := td.Price
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Price(NewDateFromFormattedString(td.Settlement), NewDateFromFormattedString(td.Maturity), td.Rate, td.Yield, td.Redemption, td.Frequency, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	settlement := NewDateFromFormattedString("2008-02-15")
+	maturity := NewDateFromFormattedString("2017-11-15")
+	if _, e := Price(maturity, settlement, 0.0575, 0.065, 100, 2, 0); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Price(settlement, maturity, 0.0575, 0.065, 100, 3, 0); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Price(settlement, maturity, 0.0575, 0.065, 100, 2, 5); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestYield validate YIELD function
+func TestYield(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetBondTestData()
+
+	for _, td := range tdTab {
+		expected := td.Yield
+		precision := getPrecisionFromFloat(td.Price) + 1 // Yield is as accurate as the (rounded) price
+
+		result, e := Yield(NewDateFromFormattedString(td.Settlement), NewDateFromFormattedString(td.Maturity), td.Rate, td.Price, td.Redemption, td.Frequency, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	settlement := NewDateFromFormattedString("2008-02-15")
+	maturity := NewDateFromFormattedString("2016-11-15")
+	if _, e := Yield(settlement, maturity, 0.0575, 0, 100, 2, 0); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
@@ -2,11 +2,15 @@ package financial
 
 // calculatedCashFlow is cashflow, with added calculated information
 type calculatedCashFlow struct {
-	CashFlow
-	OrderId   int
-	Period    int
-	Interest  float64
-	Principal float64
+	CashFlow               // Date and total payment of the period (including prepayment)
+	OrderId        int     // Position of the row in the schedule, starting from 1
+	Period         int     // Loan period of the payment
+	OpeningBalance float64 // Balance before the payment
+	Linkage        float64 // Linkage differential, revaluation of the opening balance by the index change
+	Interest       float64
+	Principal      float64 // Negative when interest is added to the balance
+	Prepayment     float64 // Paid on top of the regular payment
+	ClosingBalance float64 // Balance after the payment
 }
 
 func NewEmptyCalculatedCashFlow() calculatedCashFlow {
@@ -0,0 +1,79 @@
+package financial
+
+import (
+	"fmt"
+)
+
+// calculatedCashFlowTab is a loan schedule, one calculatedCashFlow per payment.
+type calculatedCashFlowTab []calculatedCashFlow
+
+// TotalInterest sums the interest of all the rows.
+func (ct calculatedCashFlowTab) TotalInterest() (result float64) {
+	for _, c := range ct {
+		result += c.Interest
+	}
+	return
+}
+
+// TotalPrincipal sums the principal of all the rows.
+func (ct calculatedCashFlowTab) TotalPrincipal() (result float64) {
+	for _, c := range ct {
+		result += c.Principal
+	}
+	return
+}
+
+// TotalLinkage sums the linkage differentials of all the rows.
+func (ct calculatedCashFlowTab) TotalLinkage() (result float64) {
+	for _, c := range ct {
+		result += c.Linkage
+	}
+	return
+}
+
+// TotalPrepayments sums the prepayments of all the rows.
+func (ct calculatedCashFlowTab) TotalPrepayments() (result float64) {
+	for _, c := range ct {
+		result += c.Prepayment
+	}
+	return
+}
+
+// TotalPayments sums the payments (flows) of all the rows.
+func (ct calculatedCashFlowTab) TotalPayments() (result float64) {
+	for _, c := range ct {
+		result += c.Flow
+	}
+	return
+}
+
+func (ct calculatedCashFlowTab) String() (result string) {
+	for _, c := range ct {
+		result = result + fmt.Sprintf("%v | Period: %v | Opening: %v | Linkage: %v | Interest: %v | Principal: %v | Prepayment: %v | Closing: %v\n",
+			c.CashFlow, c.Period, c.OpeningBalance, c.Linkage, c.Interest, c.Principal, c.Prepayment, c.ClosingBalance)
+	}
+	return
+}
+
+// CashFlowTab converts the schedule to a CashFlowTab, starting with the loan itself (negative) at loanDate.
+// The result can be used with Xirr, Xnpv etc.
+func (ct calculatedCashFlowTab) CashFlowTab(loanDate Date) (result CashFlowTab) {
+	if len(ct) == 0 {
+		return
+	}
+	result = append(result, CashFlow{loanDate, -ct[0].OpeningBalance})
+	for _, c := range ct {
+		result = append(result, c.CashFlow)
+	}
+	return
+}
+
+// After returns the rows dated after d, the remaining schedule at d.
+func (ct calculatedCashFlowTab) After(d Date) (result calculatedCashFlowTab) {
+	for _, c := range ct {
+		if c.Date.Date.After(d.Date) {
+			result = append(result, c)
+		}
+	}
+	return
+}
@@ -35,6 +35,46 @@ func NewCashFlowPayments(a float64, fDate string, months int64, rate float64) (r
 	return
 }
 
+// NewCashFlowTabWithBalloon created new CashFlowTab like NewCashFlowTab, with additional balloon flow at balloonDate.
+func NewCashFlowTabWithBalloon(first float64, fDate string, months int, in float64, inDate string, balloon float64, balloonDate string) (result CashFlowTab) {
+	result = NewCashFlowTab(first, fDate, months, in, inDate)
+	if balloon != 0 {
+		result = result.AddFlow(CashFlow{NewDateFromFormattedString(balloonDate), balloon})
+	}
+	return
+}
+
+// NewCashFlowPaymentsWithBalloon created new CashFlowTab of a loan, where balloon is paid with the last payment.
+// The payment is calculated so the balloon is left after the monthly payments.
+// Balloons are paid only at maturity, use NewCashFlowTabWithBalloon for a balloon on another date.
+func NewCashFlowPaymentsWithBalloon(a float64, fDate string, months int64, rate float64, balloon float64) (result CashFlowTab) {
+	pmt := Pmt(rate, months, -a, balloon, false)
+	d := NewDateFromFormattedString(fDate).AddMonth()
+	result = NewCashFlowTab(-a, fDate, int(months), pmt, d.String())
+	if months > 0 {
+		result[len(result)-1].Flow += balloon
+	}
+	return
+}
+
+// AddFlow returns a copy of the tab with the flow added to the record with the same date, or inserted ordered by date.
+// The tab is expected to be ordered by date.
+func (ca CashFlowTab) AddFlow(c CashFlow) (r CashFlowTab) {
+	i := sort.Search(len(ca), func(i int) bool {
+		return !ca[i].Date.Date.Before(c.Date.Date)
+	})
+	if i < len(ca) && ca[i].Date.Date.Equal(c.Date.Date) {
+		r = append(CashFlowTab(nil), ca...)
+		r[i].Flow += c.Flow
+		return
+	}
+	r = make(CashFlowTab, 0, len(ca)+1)
+	r = append(r, ca[:i]...)
+	r = append(r, c)
+	r = append(r, ca[i:]...)
+	return
+}
+
 func (ca CashFlowTab) FirstFlow() float64 {
 	if len(ca) > 0 {
 		return ca[0].Flow
@@ -49,6 +89,14 @@ func (ca CashFlowTab) FirstDate() Date {
 	panic(ErrEmptySlice)
 }
 
+// Flows returns the flows, neglecting dates.
+func (ca CashFlowTab) Flows() (result []float64) {
+	for _, c := range ca {
+		result = append(result, c.Flow)
+	}
+	return
+}
+
 func (ca CashFlowTab) String() (result string) {
 	for _, c := range ca {
 		result = result + c.String() + "\n"
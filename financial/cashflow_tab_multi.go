@@ -1,3 +1,26 @@
 package financial
 
+import (
+	"sort"
+)
+
 type cashFlowTabMulti []CashFlowTab
+
+// Merge combines the tabs into one CashFlowTab ordered by date, flows of the same date are summed.
+// The tabs are not changed.
+func (cftm cashFlowTabMulti) Merge() (result CashFlowTab) {
+	var all CashFlowTab
+	for _, cft := range cftm {
+		all = append(all, cft...)
+	}
+	sort.Stable(all)
+
+	for _, c := range all {
+		if n := len(result); n > 0 && result[n-1].Date.Date.Equal(c.Date.Date) {
+			result[n-1].Flow += c.Flow
+			continue
+		}
+		result = append(result, c)
+	}
+	return
+}
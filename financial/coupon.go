@@ -0,0 +1,155 @@
+package financial
+
+// Coupon helpers, the settlement is before the maturity, frequency and basis are valid.
+
+// getCouppcd returns the previous coupon date, on or before the settlement.
+func getCouppcd(aSettle, aMat scaDate, nFreq int64) scaDate {
+	rDate := aMat
+	rDate.setYear(aSettle.year)
+	if rDate.less(aSettle) {
+		rDate.addYears(1)
+	}
+	for aSettle.less(rDate) {
+		rDate.addMonths(-12 / int(nFreq))
+	}
+	return rDate
+}
+
+// getCoupncd returns the next coupon date, after the settlement.
+func getCoupncd(aSettle, aMat scaDate, nFreq int64) scaDate {
+	rDate := aMat
+	rDate.setYear(aSettle.year)
+	if aSettle.less(rDate) {
+		rDate.addYears(-1)
+	}
+	for !aSettle.less(rDate) {
+		rDate.addMonths(12 / int(nFreq))
+	}
+	return rDate
+}
+
+// getCoupdaybs returns the days from the beginning of the coupon period to the settlement.
+func getCoupdaybs(nSettle, nMat Date, nFreq, nBase int64) float64 {
+	aSettle := newScaDate(nSettle, nBase)
+	aDate := getCouppcd(aSettle, newScaDate(nMat, nBase), nFreq)
+	return float64(scaDateDiff(aDate, aSettle))
+}
+
+// getCoupdays returns the days in the coupon period of the settlement.
+func getCoupdays(nSettle, nMat Date, nFreq, nBase int64) float64 {
+	if nBase == 1 {
+		aDate := getCouppcd(newScaDate(nSettle, nBase), newScaDate(nMat, nBase), nFreq)
+		aNextDate := aDate
+		aNextDate.addMonths(12 / int(nFreq))
+		return float64(scaDateDiff(aDate, aNextDate))
+	}
+	return getDaysInYear(nBase) / float64(nFreq)
+}
+
+// getCoupdaysnc returns the days from the settlement to the next coupon date.
+func getCoupdaysnc(nSettle, nMat Date, nFreq, nBase int64) float64 {
+	if nBase != 0 && nBase != 4 {
+		aSettle := newScaDate(nSettle, nBase)
+		aDate := getCoupncd(aSettle, newScaDate(nMat, nBase), nFreq)
+		return float64(scaDateDiff(aSettle, aDate))
+	}
+	return getCoupdays(nSettle, nMat, nFreq, nBase) - getCoupdaybs(nSettle, nMat, nFreq, nBase)
+}
+
+// getCoupnum returns the number of coupons payable between the settlement and the maturity.
+func getCoupnum(nSettle, nMat Date, nFreq, nBase int64) float64 {
+	aMat := newScaDate(nMat, nBase)
+	aDate := getCouppcd(newScaDate(nSettle, nBase), aMat, nFreq)
+	nMonths := (aMat.year-aDate.year)*12 + aMat.month - aDate.month
+	return float64(int64(nMonths) * nFreq / 12)
+}
+
+// getCouponFraction returns the coupon periods between aFrom and aTo, counted by the (quasi) coupon periods of aAnchor.
+// Every quasi coupon period adds the part of its days that is between the dates.
+func getCouponFraction(aFrom, aTo, aAnchor scaDate, nFreq, nBase int64) (fRet float64) {
+	aDate := getCouppcd(aFrom, aAnchor, nFreq)
+	for aDate.less(aTo) {
+		aNext := aDate
+		aNext.addMonths(12 / int(nFreq))
+
+		aStart, aEnd := aDate, aNext
+		if aStart.less(aFrom) {
+			aStart = aFrom
+		}
+		if aTo.less(aEnd) {
+			aEnd = aTo
+		}
+
+		fDays := getDaysInYear(nBase) / float64(nFreq)
+		if nBase == 1 {
+			fDays = float64(scaDateDiff(aDate, aNext))
+		}
+		fRet += float64(scaDateDiff(aStart, aEnd)) / fDays
+		aDate = aNext
+	}
+	return
+}
+
+// validCoupon returns true for valid coupon functions parameters.
+func validCoupon(settlement Date, maturity Date, frequency int64, basis int64) bool {
+	return settlement.Date.Before(maturity.Date) && validFrequency(frequency) && validBasis(basis)
+}
+
+// CoupDayBs returns the days from the beginning of the coupon period to the settlement, like Excel COUPDAYBS.
+// Coupon dates are counted back from the maturity, a maturity on the end of month has all the coupons on the end of month.
+// Frequency: 1=yearly, 2=half yearly, 4=quarterly.
+// Basis: 0=USA (NASD) 30/360, 1=exact/exact, 2=exact/360, 3=exact/365, 4=Europe 30/360
+func CoupDayBs(settlement Date, maturity Date, frequency int64, basis int64) (float64, error) {
+	if !validCoupon(settlement, maturity, frequency, basis) {
+		return 0, ErrParametersError
+	}
+	return getCoupdaybs(settlement, maturity, frequency, basis), nil
+}
+
+// CoupDays returns the days in the coupon period of the settlement, like Excel COUPDAYS.
+func CoupDays(settlement Date, maturity Date, frequency int64, basis int64) (float64, error) {
+	if !validCoupon(settlement, maturity, frequency, basis) {
+		return 0, ErrParametersError
+	}
+	return getCoupdays(settlement, maturity, frequency, basis), nil
+}
+
+// CoupDaysNc returns the days from the settlement to the next coupon date, like Excel COUPDAYSNC.
+func CoupDaysNc(settlement Date, maturity Date, frequency int64, basis int64) (float64, error) {
+	if !validCoupon(settlement, maturity, frequency, basis) {
+		return 0, ErrParametersError
+	}
+	return getCoupdaysnc(settlement, maturity, frequency, basis), nil
+}
+
+// CoupNcd returns the next coupon date after the settlement, like Excel COUPNCD.
+func CoupNcd(settlement Date, maturity Date, frequency int64, basis int64) (Date, error) {
+	if !validCoupon(settlement, maturity, frequency, basis) {
+		return Date{}, ErrParametersError
+	}
+	return getCoupncd(newScaDate(settlement, basis), newScaDate(maturity, basis), frequency).date(), nil
+}
+
+// CoupNum returns the number of coupons payable between the settlement and the maturity, like Excel COUPNUM.
+func CoupNum(settlement Date, maturity Date, frequency int64, basis int64) (float64, error) {
+	if !validCoupon(settlement, maturity, frequency, basis) {
+		return 0, ErrParametersError
+	}
+	return getCoupnum(settlement, maturity, frequency, basis), nil
+}
+
+// CoupPcd returns the previous coupon date, on or before the settlement, like Excel COUPPCD.
+func CoupPcd(settlement Date, maturity Date, frequency int64, basis int64) (Date, error) {
+	if !validCoupon(settlement, maturity, frequency, basis) {
+		return Date{}, ErrParametersError
+	}
+	return getCouppcd(newScaDate(settlement, basis), newScaDate(maturity, basis), frequency).date(), nil
+}
+
+// getDaysInYear returns the days in year of the basis, exact/exact is not fixed and is not handled here.
+func getDaysInYear(nBase int64) float64 {
+	if nBase == 3 {
+		return 365
+	}
+	return 360
+}
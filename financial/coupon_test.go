@@ -0,0 +1,65 @@
+package financial
+
+import (
+	"github.com/aviplot/go-finance-math/test/testdata"
+	"testing"
+)
+
+// TestCoupon validate COUPDAYBS, COUPDAYS, COUPDAYSNC, COUPNCD, COUPNUM and COUPPCD functions
+func TestCoupon(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetCouponTestData()
+
+	for _, td := range tdTab {
+		settlement := NewDateFromFormattedString(td.Settlement)
+		maturity := NewDateFromFormattedString(td.Maturity)
+
+		days := []struct {
+			f        func(Date, Date, int64, int64) (float64, error)
+			expected float64
+		}{
+			{CoupDayBs, td.DayBs},
+			{CoupDays, td.Days},
+			{CoupDaysNc, td.DaysNc},
+			{CoupNum, td.Num},
+		}
+		for _, d := range days {
+			result, e := d.f(settlement, maturity, td.Frequency, td.Basis)
+			if e != nil {
+				t.Fatalf("Error: %v", e)
+			}
+			if result != d.expected {
+				t.Fatalf("Error, settlement: %v maturity: %v basis: %v result: \"%v\" Expected: \"%v\"", settlement, maturity, td.Basis, result, d.expected)
+			}
+		}
+
+		dates := []struct {
+			f        func(Date, Date, int64, int64) (Date, error)
+			expected string
+		}{
+			{CoupNcd, td.Ncd},
+			{CoupPcd, td.Pcd},
+		}
+		for _, d := range dates {
+			result, e := d.f(settlement, maturity, td.Frequency, td.Basis)
+			if e != nil {
+				t.Fatalf("Error: %v", e)
+			}
+			if result.String() != d.expected {
+				t.Fatalf("Error, settlement: %v maturity: %v result: \"%v\" Expected: \"%v\"", settlement, maturity, result, d.expected)
+			}
+		}
+	}
+
+	settlement := NewDateFromFormattedString("2011-01-25")
+	maturity := NewDateFromFormattedString("2011-11-15")
+	if _, e := CoupDays(maturity, settlement, 2, 1); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := CoupNcd(settlement, maturity, 12, 1); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := CoupPcd(settlement, maturity, 2, -1); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
@@ -57,6 +57,23 @@ func (d Date) AddMonth() (dr Date) {
 	return
 }
 
+// AddMonths adds n months (may be negative), the day is limited to the end of the month (2022-08-31 +1 is 2022-09-30).
+// Unlike AddMonth, which rolls to the next month like time.AddDate.
+func (d Date) AddMonths(n int) (dr Date) {
+	nYear, nMonth, nDay := d.Date.Date()
+	first := time.Date(nYear, nMonth, 1, 0, 0, 0, 0, time.UTC).AddDate(0, n, 0)
+	if nLastDay := daysInMonth(int(first.Month()), first.Year()); nDay > nLastDay {
+		nDay = nLastDay
+	}
+	dr.Date = first.AddDate(0, 0, nDay-1)
+	return
+}
+
+// IsEndOfMonth returns true for the last day of the month.
+func (d Date) IsEndOfMonth() bool {
+	return d.Date.Day() == daysInMonth(int(d.Date.Month()), d.Date.Year())
+}
+
 func (d Date) String() string {
 	//return fmt.Sprintf("%04d-%02d-%02d", d.Date.Year(), int(d.Date.Month()), d.Date.Day())
 	return d.Date.Format(layout)
@@ -26,3 +26,51 @@ func TestAddMonth(t *testing.T) {
 		}
 	}
 }
+
+// TestYearFrac validate YEARFRAC function, by basis
+func TestYearFrac(t *testing.T) {
+	for _, td := range testdata.TESTGetYearFracTestData() {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := YearFrac(NewDateFromFormattedString(td.Start), NewDateFromFormattedString(td.End), td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	if _, e := YearFrac(NewDateFromFormattedString("2012-01-01"), NewDateFromFormattedString("2012-07-30"), 5); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestAddMonths validate adding months, limited to the end of the month
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		date     string
+		months   int
+		expected string
+	}{
+		{"2022-08-31", 1, "2022-09-30"},
+		{"2022-08-31", 6, "2023-02-28"},
+		{"2024-02-29", 12, "2025-02-28"},
+		{"2023-03-31", -1, "2023-02-28"},
+		{"2022-01-15", -13, "2020-12-15"},
+		{"2022-01-15", 0, "2022-01-15"},
+	}
+
+	for _, tc := range tests {
+		result := NewDateFromFormattedString(tc.date).AddMonths(tc.months)
+		if result.String() != tc.expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result, tc.expected)
+		}
+	}
+
+	if !NewDateFromFormattedString("2024-02-29").IsEndOfMonth() || NewDateFromFormattedString("2023-02-27").IsEndOfMonth() {
+		t.Fatalf("Error, end of month")
+	}
+}
@@ -0,0 +1,259 @@
+package financial
+
+import (
+	"math"
+)
+
+// Sln, Syd, Db, Ddb and Vdb are a translation to Golang from LibreOffice:
+// https://github.com/LibreOffice/core/blob/master/sc/source/core/tool/interpr2.cxx
+// Amordegrc and Amorlinc are from the open office analysis add-in (financial.cxx, analysishelper.cxx).
+
+// approxFloor is floor, neglecting floating point errors (like rtl::math::approxFloor).
+func approxFloor(f float64) float64 {
+	return math.Floor(round(f, 9))
+}
+
+// approxCeil is ceil, neglecting floating point errors (like rtl::math::approxCeil).
+func approxCeil(f float64) float64 {
+	return math.Ceil(round(f, 9))
+}
+
+// Sln returns the straight-line depreciation for one period, like Excel SLN.
+func Sln(cost float64, salvage float64, life float64) (float64, error) {
+	if life == 0 {
+		return 0, ErrParametersError
+	}
+	return (cost - salvage) / life, nil
+}
+
+// Syd returns the sum-of-years' digits depreciation for period, like Excel SYD.
+func Syd(cost float64, salvage float64, life float64, period float64) (float64, error) {
+	if life <= 0 || period <= 0 || period > life {
+		return 0, ErrParametersError
+	}
+	return (cost - salvage) * (life - period + 1) * 2 / (life * (life + 1)), nil
+}
+
+// Db returns the fixed-declining balance depreciation for period, like Excel DB.
+// months is the number of months in the first year (12 for a full year), when less than 12,
+// the remainder is depreciated in period life+1.
+func Db(cost float64, salvage float64, life float64, period float64, months float64) (float64, error) {
+	if months < 1 || months > 12 || life > 1200 || salvage < 0 || period > life+1 ||
+		salvage > cost || cost <= 0 || life <= 0 || period <= 0 {
+		return 0, ErrParametersError
+	}
+
+	// Rate is rounded to 3 digits
+	fOffRate := 1 - math.Pow(salvage/cost, 1/life)
+	fOffRate = approxFloor(fOffRate*1000+0.5) / 1000
+	fFirstOffRate := cost * fOffRate * months / 12
+
+	if approxFloor(period) == 1 {
+		return fFirstOffRate, nil
+	}
+
+	var fGda float64
+	fSumOffRate := fFirstOffRate
+	nMax := int64(approxFloor(math.Min(life, period)))
+	for i := int64(2); i <= nMax; i++ {
+		fGda = (cost - fSumOffRate) * fOffRate
+		fSumOffRate += fGda
+	}
+	if period > life {
+		fGda = (cost - fSumOffRate) * fOffRate * (12 - months) / 12
+	}
+	return fGda, nil
+}
+
+// getGda returns the declining balance depreciation for period (ScGetGDA).
+func getGda(fWert, fRest, fDauer, fPeriode, fFaktor float64) float64 {
+	var fAlterWert float64
+	fZins := fFaktor / fDauer
+	if fZins >= 1 {
+		fZins = 1
+		if fPeriode == 1 {
+			fAlterWert = fWert
+		} else {
+			fAlterWert = 0
+		}
+	} else {
+		fAlterWert = fWert * math.Pow(1-fZins, fPeriode-1)
+	}
+	fNeuerWert := fWert * math.Pow(1-fZins, fPeriode)
+
+	var fGda float64
+	if fNeuerWert < fRest {
+		fGda = fAlterWert - fRest
+	} else {
+		fGda = fAlterWert - fNeuerWert
+	}
+	if fGda < 0 {
+		fGda = 0
+	}
+	return fGda
+}
+
+// Ddb returns the declining balance depreciation for period, like Excel DDB.
+// factor is the rate at which the balance declines, 2 for double-declining balance.
+func Ddb(cost float64, salvage float64, life float64, period float64, factor float64) (float64, error) {
+	if cost < 0 || salvage < 0 || factor <= 0 || salvage > cost || period < 1 || period > life {
+		return 0, ErrParametersError
+	}
+	return getGda(cost, salvage, life, period, factor), nil
+}
+
+// interVdb sums the depreciation of the first periods, switching to straight-line when it is higher (ScInterVDB).
+func interVdb(fCost, fSalvage, fLife, fLife1, fPeriod, fFactor float64) float64 {
+	var fVdb, fTerm, fSln float64
+	fIntEnd := approxCeil(fPeriod)
+	nLoopEnd := int64(fIntEnd)
+	fSalvageValue := fCost - fSalvage
+	bNowSln := false
+
+	for i := int64(1); i <= nLoopEnd; i++ {
+		if !bNowSln {
+			fDdb := getGda(fCost, fSalvage, fLife, float64(i), fFactor)
+			fSln = fSalvageValue / (fLife1 - float64(i-1))
+
+			if fSln > fDdb {
+				fTerm = fSln
+				bNowSln = true
+			} else {
+				fTerm = fDdb
+				fSalvageValue -= fDdb
+			}
+		} else {
+			fTerm = fSln
+		}
+
+		if i == nLoopEnd {
+			fTerm *= fPeriod + 1 - fIntEnd
+		}
+		fVdb += fTerm
+	}
+	return fVdb
+}
+
+// Vdb returns the declining balance depreciation between start and end (may be partial periods), like Excel VDB.
+// When noSwitch is false, switches to straight-line depreciation when it is greater than the declining balance.
+func Vdb(cost float64, salvage float64, life float64, start float64, end float64, factor float64, noSwitch bool) (fVdb float64, e error) {
+	if start < 0 || end < start || end > life || cost < 0 || salvage > cost || factor <= 0 {
+		e = ErrParametersError
+		return
+	}
+
+	fIntStart := approxFloor(start)
+	fIntEnd := approxCeil(end)
+	nLoopStart := int64(fIntStart)
+	nLoopEnd := int64(fIntEnd)
+
+	if noSwitch {
+		for i := nLoopStart + 1; i <= nLoopEnd; i++ {
+			fTerm := getGda(cost, salvage, life, float64(i), factor)
+
+			// Partial period in the beginning or the end
+			if i == nLoopStart+1 {
+				fTerm *= math.Min(end, fIntStart+1) - start
+			} else if i == nLoopEnd {
+				fTerm *= end + 1 - fIntEnd
+			}
+			fVdb += fTerm
+		}
+		return
+	}
+
+	var fPart float64
+	if round(start, 9) != fIntStart {
+		// Part to be subtracted at the beginning
+		fTempValue := cost - interVdb(cost, salvage, life, life, fIntStart, factor)
+		fPart += (start - fIntStart) * interVdb(fTempValue, salvage, life, life-fIntStart, 1, factor)
+	}
+	if round(end, 9) != fIntEnd {
+		// Part to be subtracted at the end
+		fTempIntStart := fIntEnd - 1
+		fTempValue := cost - interVdb(cost, salvage, life, life, fTempIntStart, factor)
+		fPart += (fIntEnd - end) * interVdb(fTempValue, salvage, life, life-fTempIntStart, fIntEnd-fTempIntStart, factor)
+	}
+
+	// Depreciation for whole periods
+	cost -= interVdb(cost, salvage, life, life, fIntStart, factor)
+	fVdb = interVdb(cost, salvage, life, life-fIntStart, fIntEnd-fIntStart, factor)
+	fVdb -= fPart
+	return
+}
+
+// Amordegrc returns the depreciation of period, using French degressive depreciation (with the coefficients by life),
+// like Excel AMORDEGRC. The first period (0) is from purchased to firstPeriod.
+// Basis 2 (exact/360) is not supported, like Excel.
+func Amordegrc(cost float64, purchased Date, firstPeriod Date, salvage float64, period float64, rate float64, basis int64) (float64, error) {
+	if purchased.Date.After(firstPeriod.Date) || rate <= 0 || salvage > cost || period < 0 || basis == 2 {
+		return 0, ErrParametersError
+	}
+	fYearFrac, e := YearFrac(purchased, firstPeriod, basis)
+	if e != nil {
+		return 0, e
+	}
+
+	nPer := int64(period)
+	fUsePer := 1 / rate
+	var fAmorCoeff float64
+	switch {
+	case fUsePer < 3:
+		fAmorCoeff = 1
+	case fUsePer < 5:
+		fAmorCoeff = 1.5
+	case fUsePer <= 6:
+		fAmorCoeff = 2
+	default:
+		fAmorCoeff = 2.5
+	}
+
+	rate *= fAmorCoeff
+	fNRate := math.Round(fYearFrac * rate * cost)
+	cost -= fNRate
+	fRest := cost - salvage // Cost - salvage - sum of the depreciation
+
+	for n := int64(0); n < nPer; n++ {
+		fNRate = math.Round(rate * cost)
+		fRest -= fNRate
+
+		if fRest < 0 {
+			if nPer-n <= 1 {
+				return math.Round(cost * 0.5), nil
+			}
+			return 0, nil
+		}
+		cost -= fNRate
+	}
+	return fNRate, nil
+}
+
+// Amorlinc returns the depreciation of period, using French linear depreciation, like Excel AMORLINC.
+// The first period (0) is from purchased to firstPeriod.
+// Basis 2 (exact/360) is not supported, like Excel.
+func Amorlinc(cost float64, purchased Date, firstPeriod Date, salvage float64, period float64, rate float64, basis int64) (float64, error) {
+	if purchased.Date.After(firstPeriod.Date) || rate <= 0 || salvage > cost || period < 0 || basis == 2 {
+		return 0, ErrParametersError
+	}
+	fYearFrac, e := YearFrac(purchased, firstPeriod, basis)
+	if e != nil {
+		return 0, e
+	}
+
+	nPer := int64(period)
+	fOneRate := cost * rate
+	fCostDelta := cost - salvage
+	f0Rate := fYearFrac * rate * cost
+	nNumOfFullPeriods := int64((cost - salvage - f0Rate) / fOneRate)
+
+	switch {
+	case nPer == 0:
+		return f0Rate, nil
+	case nPer <= nNumOfFullPeriods:
+		return fOneRate, nil
+	case nPer == nNumOfFullPeriods+1:
+		return fCostDelta - fOneRate*float64(nNumOfFullPeriods) - f0Rate, nil
+	default:
+		return 0, nil
+	}
+}
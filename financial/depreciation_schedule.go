@@ -0,0 +1,107 @@
+package financial
+
+type DepreciationMethod int
+
+const (
+	StraightLine      DepreciationMethod = iota + 1 // Sln
+	SumOfYearsDigits                                // Syd
+	FixedDeclining                                  // Db
+	DecliningBalance                                // Ddb
+	VariableDeclining                               // Vdb, switching to straight-line
+)
+
+// Asset holds the terms of a depreciated asset, depreciated yearly.
+type Asset struct {
+	Cost            float64
+	Salvage         float64 // Value at the end of the life
+	Life            int64   // Years
+	FirstDate       Date    // Date of the first depreciation, next are one year apart (on the same day, or the end of month)
+	Method          DepreciationMethod
+	Factor          float64 // Declining rate of DecliningBalance and VariableDeclining, 2 for double-declining
+	FirstYearMonths float64 // Months in the first year of FixedDeclining, zero for a full year
+}
+
+// DepreciationRow is the depreciation of one year.
+type DepreciationRow struct {
+	Date         Date
+	Period       int
+	Depreciation float64
+	Accumulated  float64 // Depreciation until (including) this period
+	BookValue    float64 // Cost less the accumulated depreciation
+}
+
+// DepreciationTab is just slice of DepreciationRow.
+type DepreciationTab []DepreciationRow
+
+// NewDepreciationSchedule returns the yearly depreciation of an asset.
+func NewDepreciationSchedule(cost float64, salvage float64, life int64, firstDate Date, m DepreciationMethod, factor float64) (DepreciationTab, error) {
+	a := Asset{
+		Cost:      cost,
+		Salvage:   salvage,
+		Life:      life,
+		FirstDate: firstDate,
+		Method:    m,
+		Factor:    factor,
+	}
+	return a.Schedule()
+}
+
+// Schedule calculates the depreciation, year by year.
+// FixedDeclining with a partial first year has an additional year at the end.
+// Unknown method is a parameters error.
+func (a Asset) Schedule() (result DepreciationTab, e error) {
+	if a.Life <= 0 || a.Method < StraightLine || a.Method > VariableDeclining {
+		e = ErrParametersError
+		return
+	}
+
+	months := a.FirstYearMonths
+	if months == 0 {
+		months = 12
+	}
+	periods := a.Life
+	if a.Method == FixedDeclining && months < 12 {
+		periods++
+	}
+
+	life := float64(a.Life)
+	accumulated := 0.0
+	for i := int64(1); i <= periods; i++ {
+		period := float64(i)
+		var depreciation float64
+		switch a.Method {
+		case StraightLine:
+			depreciation, e = Sln(a.Cost, a.Salvage, life)
+		case SumOfYearsDigits:
+			depreciation, e = Syd(a.Cost, a.Salvage, life, period)
+		case FixedDeclining:
+			depreciation, e = Db(a.Cost, a.Salvage, life, period, months)
+		case DecliningBalance:
+			depreciation, e = Ddb(a.Cost, a.Salvage, life, period, a.Factor)
+		case VariableDeclining:
+			depreciation, e = Vdb(a.Cost, a.Salvage, life, period-1, period, a.Factor, false)
+		}
+		if e != nil {
+			result = nil
+			return
+		}
+
+		accumulated += depreciation
+		result = append(result, DepreciationRow{
+			Date:         a.FirstDate.AddMonths(12 * int(i-1)), // Counted from the first date, so it does not drift
+			Period:       int(i),
+			Depreciation: depreciation,
+			Accumulated:  accumulated,
+			BookValue:    a.Cost - accumulated,
+		})
+	}
+	return
+}
+
+// Total sums the depreciation of all the rows.
+func (dt DepreciationTab) Total() (result float64) {
+	for _, r := range dt {
+		result += r.Depreciation
+	}
+	return
+}
@@ -0,0 +1,214 @@
+package financial
+
+import (
+	"github.com/aviplot/go-finance-math/test/testdata"
+	"testing"
+)
+
+// TestSlnSyd validate SLN and SYD functions
+func TestSlnSyd(t *testing.T) {
+	for _, td := range testdata.TESTGetSlnTestData() {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Sln(td.Cost, td.Salvage, td.Life)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	for _, td := range testdata.TESTGetSydTestData() {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Syd(td.Cost, td.Salvage, td.Life, td.Period)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	if _, e := Sln(30000, 7500, 0); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Syd(30000, 7500, 10, 11); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestDb validate DB function, including partial first year
+func TestDb(t *testing.T) {
+	for _, td := range testdata.TESTGetDbTestData() {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Db(td.Cost, td.Salvage, td.Life, td.Period, td.Months)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	if _, e := Db(1000000, 100000, 6, 8, 7); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Db(1000000, 100000, 6, 1, 13); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestDdbVdb validate DDB and VDB functions
+func TestDdbVdb(t *testing.T) {
+	for _, td := range testdata.TESTGetDdbTestData() {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Ddb(td.Cost, td.Salvage, td.Life, td.Period, td.Factor)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	for _, td := range testdata.TESTGetVdbTestData() {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Vdb(td.Cost, td.Salvage, td.Life, td.Period, td.End, td.Factor, td.NoSwitch)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	if _, e := Ddb(2400, 300, 10, 0, 2); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Vdb(2400, 300, 10, 5, 4, 2, false); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestDepreciationSchedule validate the schedule rows against the depreciation functions
+func TestDepreciationSchedule(t *testing.T) {
+	first := NewDateFromFormattedString("2020-12-31")
+	methods := []DepreciationMethod{StraightLine, SumOfYearsDigits, VariableDeclining}
+
+	// Fully depreciated to the salvage
+	for _, m := range methods {
+		s, e := NewDepreciationSchedule(30000, 7500, 10, first, m, 2)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		if len(s) != 10 {
+			t.Fatalf("Error, rows: \"%v\" Expected: \"%v\"", len(s), 10)
+		}
+		if round(s.Total(), 6) != 22500 || round(s[9].BookValue, 6) != 7500 {
+			t.Fatalf("Error, method: %v total: \"%v\" book value: \"%v\"", m, s.Total(), s[9].BookValue)
+		}
+		if s[9].Date.String() != "2029-12-31" {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", s[9].Date, "2029-12-31")
+		}
+	}
+
+	s, _ := NewDepreciationSchedule(30000, 7500, 10, first, SumOfYearsDigits, 0)
+	if round(s[0].Depreciation, 2) != 4090.91 {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", s[0].Depreciation, 4090.91)
+	}
+
+	// Partial first year adds a year
+	a := Asset{
+		Cost:            1000000,
+		Salvage:         100000,
+		Life:            6,
+		FirstDate:       first,
+		Method:          FixedDeclining,
+		FirstYearMonths: 7,
+	}
+	s, e := a.Schedule()
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	tdTab := testdata.TESTGetDbTestData()
+	if len(s) != len(tdTab) {
+		t.Fatalf("Error, rows: \"%v\" Expected: \"%v\"", len(s), len(tdTab))
+	}
+	for i, td := range tdTab {
+		if round(s[i].Depreciation, 2) != td.Result {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", s[i].Depreciation, td.Result)
+		}
+	}
+
+	// Dates do not drift from the end of February
+	s, e = NewDepreciationSchedule(30000, 7500, 5, NewDateFromFormattedString("2024-02-29"), StraightLine, 0)
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	for i, expected := range []string{"2024-02-29", "2025-02-28", "2026-02-28", "2027-02-28", "2028-02-29"} {
+		if s[i].Date.String() != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", s[i].Date, expected)
+		}
+	}
+
+	if _, e := NewDepreciationSchedule(30000, 7500, 10, first, DepreciationMethod(0), 2); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestAmordegrcAmorlinc validate AMORDEGRC and AMORLINC functions
+func TestAmordegrcAmorlinc(t *testing.T) {
+	for _, td := range testdata.TESTGetAmorTestData() {
+		purchased := NewDateFromFormattedString(td.Purchased)
+		firstPeriod := NewDateFromFormattedString(td.FirstPeriod)
+
+		expected := td.Amordegrc
+		precision := getPrecisionFromFloat(expected)
+		result, e := Amordegrc(td.Cost, purchased, firstPeriod, td.Salvage, td.Period, td.Rate, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		expected = td.Amorlinc
+		precision = getPrecisionFromFloat(expected)
+		result, e = Amorlinc(td.Cost, purchased, firstPeriod, td.Salvage, td.Period, td.Rate, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	purchased := NewDateFromFormattedString("2008-08-19")
+	firstPeriod := NewDateFromFormattedString("2008-12-31")
+	if _, e := Amordegrc(2400, purchased, firstPeriod, 300, 1, 0.15, 2); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Amorlinc(2400, purchased, firstPeriod, 300, 1, 0.15, 5); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Amorlinc(2400, firstPeriod, purchased, 300, 1, 0.15, 1); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
@@ -0,0 +1,80 @@
+package financial
+
+import (
+	"math"
+)
+
+// getDuration is DURATION calculation, parameters are valid.
+// Like Excel, the time of the flows is counted in coupon periods (open office uses YearFrac of the basis).
+func getDuration(nSettle, nMat Date, fCoup, fYield float64, nFreq, nBase int64) float64 {
+	fFreq := float64(nFreq)
+	fNumOfCoups := getCoupnum(nSettle, nMat, nFreq, nBase)
+	fDSC_E := getCoupdaysnc(nSettle, nMat, nFreq, nBase) / getCoupdays(nSettle, nMat, nFreq, nBase)
+
+	fCoup *= 100 / fFreq // fCoup is used as cash flow
+	fYield = 1 + fYield/fFreq
+
+	var fDur, p float64
+	for k := 1.0; k <= fNumOfCoups; k++ {
+		t := k - 1 + fDSC_E
+		c := fCoup
+		if k == fNumOfCoups {
+			c += 100
+		}
+		fDur += t * c / math.Pow(fYield, t)
+		p += c / math.Pow(fYield, t)
+	}
+	return fDur / p / fFreq
+}
+
+// Duration returns the Macaulay duration (in years) of a security that pays periodic interest, like Excel DURATION.
+// Frequency: 1=yearly, 2=half yearly, 4=quarterly.
+// Basis: 0=USA (NASD) 30/360, 1=exact/exact, 2=exact/360, 3=exact/365, 4=Europe 30/360
+func Duration(settlement Date, maturity Date, coupon float64, yld float64, frequency int64, basis int64) (float64, error) {
+	if coupon < 0 || yld < 0 || !validCoupon(settlement, maturity, frequency, basis) {
+		return 0, ErrParametersError
+	}
+	return getDuration(settlement, maturity, coupon, yld, frequency, basis), nil
+}
+
+// MDuration returns the modified duration (in years) of a security that pays periodic interest, like Excel MDURATION.
+func MDuration(settlement Date, maturity Date, coupon float64, yld float64, frequency int64, basis int64) (float64, error) {
+	fRet, e := Duration(settlement, maturity, coupon, yld, frequency, basis)
+	if e != nil {
+		return 0, e
+	}
+	return fRet / (1 + yld/float64(frequency)), nil
+}
+
+// MacaulayDuration returns the weighted average time (in years) of the flows, weighted by their present value.
+// Time is measured from the first date (days/365, like Xnpv), flows on the first date (the investment) are not included.
+func MacaulayDuration(rate float64, cf CashFlowTab) (float64, error) {
+	if len(cf) < 2 || rate <= -1 {
+		return 0, ErrParametersError
+	}
+
+	fNull := cf.FirstDate()
+	var fDur, fPv float64
+	for _, c := range cf {
+		t := float64(c.Date.DaysFrom(fNull)) / 365.0
+		if t == 0 {
+			continue
+		}
+		v := c.Flow / math.Pow(1+rate, t)
+		fDur += t * v
+		fPv += v
+	}
+	if fPv == 0 {
+		return 0, ErrCalculationError
+	}
+	return fDur / fPv, nil
+}
+
+// ModifiedDuration returns the Macaulay duration divided by 1+rate, the relative change of the value by the rate.
+func ModifiedDuration(rate float64, cf CashFlowTab) (float64, error) {
+	fRet, e := MacaulayDuration(rate, cf)
+	if e != nil {
+		return 0, e
+	}
+	return fRet / (1 + rate), nil
+}
@@ -0,0 +1,98 @@
+package financial
+
+import (
+	"github.com/aviplot/go-finance-math/test/testdata"
+	"math"
+	"testing"
+)
+
+// TestDuration validate DURATION and MDURATION functions
+func TestDuration(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetDurationTestData()
+
+	for _, td := range tdTab {
+		settlement := NewDateFromFormattedString(td.Settlement)
+		maturity := NewDateFromFormattedString(td.Maturity)
+
+		expected := td.Duration
+		precision := getPrecisionFromFloat(expected)
+		result, e := Duration(settlement, maturity, td.Coupon, td.Yield, td.Frequency, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		expected = td.MDuration
+		precision = getPrecisionFromFloat(expected)
+		result, e = MDuration(settlement, maturity, td.Coupon, td.Yield, td.Frequency, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	settlement := NewDateFromFormattedString("2008-01-01")
+	maturity := NewDateFromFormattedString("2016-01-01")
+	if _, e := Duration(settlement, maturity, -0.08, 0.09, 2, 1); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := MDuration(maturity, settlement, 0.08, 0.09, 2, 1); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestMacaulayDuration validate duration of dated flows
+func TestMacaulayDuration(t *testing.T) {
+	// Level annuity, paid yearly (365 days apart)
+	r := 0.07
+	cf := CashFlowTab{{NewDateFromFormattedString("2021-01-01"), -1000}}
+	for _, d := range []string{"2022-01-01", "2023-01-01", "2024-01-01", "2024-12-31", "2025-12-31"} {
+		cf = append(cf, CashFlow{NewDateFromFormattedString(d), 250})
+	}
+	expected := (1+r)/r - 5/(math.Pow(1+r, 5)-1)
+
+	result, e := MacaulayDuration(r, cf)
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	if round(result, 9) != round(expected, 9) {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result, expected)
+	}
+	result, _ = ModifiedDuration(r, cf)
+	if round(result, 9) != round(expected/(1+r), 9) {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result, expected/(1+r))
+	}
+
+	// Single flow, the duration is the time to the flow
+	cf = CashFlowTab{{NewDateFromFormattedString("2021-01-01"), -1000}, {NewDateFromFormattedString("2023-07-02"), 1200}}
+	result, _ = MacaulayDuration(0.05, cf)
+	if round(result, 9) != round(912.0/365, 9) {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result, 912.0/365)
+	}
+
+	// Loan payments, modified duration is the relative change of the value (numeric derivative)
+	td := testdata.TESTGetLoanTestData()[1]
+	s, _ := NewAmortizationSchedule(td.Principal, td.Rate, td.Periods, NewDateFromFormattedString(td.FirstPayment), Shpitzer)
+	cf = s.CashFlowTab(NewDateFromFormattedString(td.LoanDate))
+	value := func(rate float64) float64 {
+		v, _ := Xnpv(rate, cf)
+		return v - cf[0].Flow
+	}
+	h := 1e-6
+	expected = -(value(td.Rate+h) - value(td.Rate-h)) / (2 * h) / value(td.Rate)
+	result, _ = ModifiedDuration(td.Rate, cf)
+	if round(result, 5) != round(expected, 5) {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result, expected)
+	}
+
+	if _, e := MacaulayDuration(-1, cf); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
@@ -0,0 +1,40 @@
+package financial
+
+// EarlyRepaymentFeeRules are the rules of the fees, on top of the discounting differential.
+type EarlyRepaymentFeeRules struct {
+	Discount       float64 // Part of the discounting differential that is waived, 0.2 is 20%
+	IndexFeeRate   float64 // CPI related fee, as a part of the balance (e.g. average index change differential)
+	OperationalFee float64 // Fixed charge
+}
+
+// EarlyRepaymentFee is the itemized fee of repaying a loan before its time.
+type EarlyRepaymentFee struct {
+	Balance                 float64 // Remaining flows discounted at the contract rate
+	MarketValue             float64 // Remaining flows discounted at the market rate
+	DiscountingDifferential float64 // MarketValue above Balance, zero if the market rate is higher
+	Discount                float64 // Waived part of the discounting differential
+	IndexFee                float64
+	OperationalFee          float64
+	Total                   float64
+}
+
+// NewEarlyRepaymentFee calculates the fee of repaying the remaining flows (cf) at date on.
+// Rates are annual, flows are discounted like Xnpv, using (days/365).
+func NewEarlyRepaymentFee(on Date, cf CashFlowTab, contractRate float64, marketRate float64, rules EarlyRepaymentFeeRules) (fee EarlyRepaymentFee, e error) {
+	if len(cf) == 0 || contractRate <= -1 || marketRate <= -1 || rules.Discount < 0 || rules.Discount > 1 ||
+		rules.IndexFeeRate < 0 || rules.OperationalFee < 0 {
+		e = ErrParametersError
+		return
+	}
+
+	fee.Balance = xnpvAt(contractRate, cf, on)
+	fee.MarketValue = xnpvAt(marketRate, cf, on)
+	if fee.MarketValue > fee.Balance {
+		fee.DiscountingDifferential = fee.MarketValue - fee.Balance
+	}
+	fee.Discount = fee.DiscountingDifferential * rules.Discount
+	fee.IndexFee = fee.Balance * rules.IndexFeeRate
+	fee.OperationalFee = rules.OperationalFee
+	fee.Total = fee.DiscountingDifferential - fee.Discount + fee.IndexFee + fee.OperationalFee
+	return
+}
@@ -131,24 +131,30 @@ func xirrResult_Deriv1(cf CashFlowTab, fRate float64) float64 {
 
 // Xirr calculation
 func Xirr(cf CashFlowTab) (fResultRate float64, e error) {
+	return xirrNewton(cf, DefaultXirrOptions())
+}
+
+// xirrNewton calculation XIRR using Newton's method, scanning for starting rates when the guess fails.
+func xirrNewton(cf CashFlowTab, o XirrOptions) (fResultRate float64, e error) {
 	if len(cf) <= 2 {
 		e = ErrParametersError
 		return
 	}
 
 	// maximum epsilon for end of iteration
-	const fMaxEps float64 = 1e-10
+	fMaxEps := o.Tolerance
 	// maximum number of iterations
-	const nMaxIter int64 = 50
+	nMaxIter := o.MaxIterations
 
 	// result interest rate, initialized with passed guessed rate, or 10%
-	fResultRate = 0.1
+	fResultRate = o.Guess
 
 	// Newton's method - try to find a fResultRate, so that lcl_sca_XirrResult() returns 0.
 	var (
 		fNewRate     float64
 		fRateEps     float64
 		fResultValue float64
+		fScanFrom    = math.Max(o.MinRate, -0.99)
 
 		nIter              int64 = 0
 		nIterScan          int64 = 0
@@ -158,11 +164,11 @@ func Xirr(cf CashFlowTab) (fResultRate float64, e error) {
 
 	// First the inner while-loop will be executed using the default Value fResultRate
 	// or the user guessed fResultRate if those do not deliver a solution for the
-	// Newton's method then the range from -0.99 to +0.99 will be scanned with a
+	// Newton's method then the range from -0.99 (or MinRate) up will be scanned with a
 	// step size of 0.01 to find fResultRate's value which can deliver a solution
 	for {
 		if nIterScan >= 1 {
-			fResultRate = -0.99 + float64(nIterScan-1)*0.01
+			fResultRate = fScanFrom + float64(nIterScan-1)*0.01
 		}
 		for {
 			fResultValue = xirrResult(cf, fResultRate)
@@ -176,11 +182,12 @@ func Xirr(cf CashFlowTab) (fResultRate float64, e error) {
 			}
 		}
 		nIter = 0
-		if math.IsNaN(fResultRate) || math.IsInf(fResultRate, 0) || math.IsNaN(fResultValue) || math.IsInf(fResultValue, 0) {
+		if math.IsNaN(fResultRate) || math.IsInf(fResultRate, 0) || math.IsNaN(fResultValue) || math.IsInf(fResultValue, 0) ||
+			fResultRate <= o.MinRate || fResultRate > o.MaxRate {
 			bContLoop = true
 		}
 		nIterScan++
-		bResultRateScanEnd = nIterScan >= 200
+		bResultRateScanEnd = nIterScan >= o.MaxScans
 		if !(bContLoop && !bResultRateScanEnd) {
 			break
 		}
@@ -254,6 +261,89 @@ func Irr(cf CashFlowTab) (guessRate float64, e error) {
 	return
 }
 
+// Mirr calculation MIRR, neglecting dates.
+// Negative flows are financed at financeRate, positive flows are reinvested at reinvestRate.
+func Mirr(cf CashFlowTab, financeRate float64, reinvestRate float64) (float64, error) {
+	nNum := len(cf)
+	if nNum < 2 || financeRate <= -1 || reinvestRate <= -1 {
+		return 0, ErrParametersError
+	}
+
+	fRate1Invest := financeRate + 1
+	fRate1Reinvest := reinvestRate + 1
+	var (
+		fNpvInvest   float64
+		fNpvReinvest float64
+		fPowInvest   = 1.0
+		fPowReinvest = 1.0
+	)
+	for _, c := range cf {
+		if c.Flow > 0 {
+			fNpvReinvest += c.Flow * fPowReinvest
+		} else {
+			fNpvInvest += c.Flow * fPowInvest
+		}
+		fPowInvest /= fRate1Invest
+		fPowReinvest /= fRate1Reinvest
+	}
+	if fNpvInvest == 0 || fNpvReinvest == 0 {
+		// Both negative and positive flows are required
+		return 0, ErrCalculationError
+	}
+
+	fPeriods := float64(nNum - 1)
+	fResult := -fNpvReinvest / fNpvInvest * math.Pow(fRate1Reinvest, fPeriods)
+	return math.Pow(fResult, 1.0/fPeriods) - 1.0, nil
+}
+
+// XMirr calculation MIRR, using dates (days/365) like Xirr.
+// Negative flows are discounted to the first date at financeRate, positive flows are compounded to the last date at reinvestRate.
+func XMirr(cf CashFlowTab, financeRate float64, reinvestRate float64) (float64, error) {
+	nNum := len(cf)
+	if nNum < 2 || financeRate <= -1 || reinvestRate <= -1 {
+		return 0, ErrParametersError
+	}
+
+	var positive, negative CashFlowTab
+	for _, c := range cf {
+		if c.Flow > 0 {
+			positive = append(positive, c)
+		} else {
+			negative = append(negative, c)
+		}
+	}
+	fFirst := cf.FirstDate()
+	fLast := cf[nNum-1].Date
+	years := float64(fLast.DaysFrom(fFirst)) / 365.0
+	fPv := xnpvAt(financeRate, negative, fFirst)
+	fFv := xnpvAt(reinvestRate, positive, fLast)
+	if fPv == 0 || fFv == 0 || years <= 0 {
+		// Both negative and positive flows, on different dates are required
+		return 0, ErrCalculationError
+	}
+	return math.Pow(-fFv/fPv, 1.0/years) - 1.0, nil
+}
+
+// Npv calculation NPV like Excel, the first value is discounted by one period.
+func Npv(rate float64, values []float64) (fRet float64, e error) {
+	if len(values) == 0 || rate == -1 {
+		e = ErrParametersError
+		return
+	}
+	fRate := rate + 1
+	for i, v := range values {
+		fRet += v / math.Pow(fRate, float64(i+1))
+	}
+	return
+}
+
+// NpvFromTimeZero calculation NPV, where the first value is at time zero (not discounted).
+// Equal to Npv(rate, values[1:]) + values[0], and to Xnpv of yearly flows.
+func NpvFromTimeZero(rate float64, values []float64) (fRet float64, e error) {
+	fRet, e = Npv(rate, values)
+	return fRet * (rate + 1), e
+}
+
 func Xnpv(fRate float64, cf CashFlowTab) (fRet float64, e error) {
 	nNum := len(cf)
 
@@ -273,6 +363,15 @@ func Xnpv(fRate float64, cf CashFlowTab) (fRet float64, e error) {
 	return
 }
 
+// xnpvAt returns the value of the flows at date d, discounting later flows and compounding earlier flows.
+func xnpvAt(fRate float64, cf CashFlowTab, d Date) (fRet float64) {
+	fRate = fRate + 1
+	for _, c := range cf {
+		fRet += c.Flow / math.Pow(fRate, float64(c.Date.DaysFrom(d))/365.0)
+	}
+	return
+}
+
 // Pv return Present Value
 func Pv(rate float64, nper int64, pmt float64, fv float64, t bool) float64 {
 	var tVal float64 = 0
@@ -302,22 +401,145 @@ func Fv(rate float64, nper int64, pmt float64, pv float64, t bool) float64 {
 	return -pv*c - pmt*(1+rate*tVal)*(c-1)/rate
 }
 
-func Xfv(rate float64, cf CashFlowTab) (fRet float64, e error) {
-	nNum := len(cf)
-
-	if nNum < 2 {
+// Rate returns the interest rate per period of an annuity, like Excel RATE (guess is usually 0.1).
+// Newton's method, taken from OpenOffice ScInterpreter::RateIteration, with support for payments at the beginning.
+func Rate(nper int64, pmt float64, pv float64, fv float64, t bool, guess float64) (fX float64, e error) {
+	if nper <= 0 {
 		e = ErrParametersError
 		return
 	}
 
-	fRet = 0.0
-	fNull := cf.FirstDate()
-	rate = rate + 1
+	// maximum epsilon for end of iteration
+	const fEpsilon float64 = 1e-7
+	// maximum number of iterations
+	const nMaxIter int = 150
 
+	var (
+		fPayType     float64
+		fNper        = float64(nper)
+		fXnew        float64
+		fGeoSeries   float64
+		fGeoSeriesD1 float64 // Derivation of fGeoSeries
+		bFound       bool
+	)
+	if t {
+		fPayType = 1
+	}
+
+	fX = guess
+	for nIter := 0; !bFound && nIter < nMaxIter; nIter++ {
+		fPowNminus1 := math.Pow(1.0+fX, fNper-1.0)
+		fPowN := fPowNminus1 * (1.0 + fX)
+		if fX == 0 {
+			fGeoSeries = fNper
+			fGeoSeriesD1 = fNper * (fNper - 1.0) / 2.0
+		} else {
+			fGeoSeries = (fPowN - 1.0) / fX
+			fGeoSeriesD1 = fNper*fPowNminus1/fX - fGeoSeries/fX
+		}
+		fTerm := fv + pv*fPowN + pmt*(1.0+fX*fPayType)*fGeoSeries
+		fTermD1 := pv*fNper*fPowNminus1 + pmt*(fPayType*fGeoSeries+(1.0+fX*fPayType)*fGeoSeriesD1)
+		if math.Abs(fTerm) < 1e-14 {
+			// will catch root which is at an extreme
+			bFound = true
+			break
+		}
+		if fTermD1 == 0 {
+			fXnew = fX + 1.1*fEpsilon // move away from zero slope
+		} else {
+			fXnew = fX - fTerm/fTermD1
+		}
+		bFound = math.Abs(fXnew-fX) < fEpsilon
+		fX = fXnew
+	}
+
+	// Like Excel, rates of -100% or less are not a solution.
+	if !bFound || math.IsNaN(fX) || math.IsInf(fX, 0) || fX <= -1 {
+		e = ErrCalculationError
+	}
+	return
+}
+
+// Nper returns the number of periods of an annuity, like Excel NPER.
+// Returns error when there is no solution, e.g. when the payment never covers the interest.
+func Nper(rate float64, pmt float64, pv float64, fv float64, t bool) (float64, error) {
+	if rate <= -1 {
+		return 0, ErrParametersError
+	}
+	if rate == 0 {
+		if pmt == 0 {
+			return 0, ErrCalculationError
+		}
+		return -(pv + fv) / pmt, nil
+	}
+
+	var tVal float64 = 0
+	if t {
+		tVal = 1
+	}
+	c := pmt * (1 + rate*tVal)
+	if c+pv*rate == 0 {
+		return 0, ErrCalculationError
+	}
+	ratio := (c - fv*rate) / (c + pv*rate)
+	if ratio <= 0 {
+		return 0, ErrCalculationError
+	}
+	return math.Log(ratio) / math.Log(1+rate), nil
+}
+
+// FvSchedule returns the future value of principal after applying a series of rates, like Excel FVSCHEDULE.
+func FvSchedule(principal float64, rates []float64) float64 {
+	for _, r := range rates {
+		principal *= 1 + r
+	}
+	return principal
+}
+
+// Rri returns the equivalent rate per period for growing pv to fv in nper periods, like Excel RRI.
+func Rri(nper float64, pv float64, fv float64) (float64, error) {
+	if nper <= 0 || pv == 0 {
+		return 0, ErrParametersError
+	}
+	fRet := math.Pow(fv/pv, 1/nper) - 1
+	if math.IsNaN(fRet) || math.IsInf(fRet, 0) {
+		return 0, ErrCalculationError
+	}
+	return fRet, nil
+}
+
+// Pduration returns the number of periods required for pv to reach fv at rate, like Excel PDURATION.
+func Pduration(rate float64, pv float64, fv float64) (float64, error) {
+	if rate <= 0 || pv <= 0 || fv <= 0 {
+		return 0, ErrParametersError
+	}
+	return math.Log(fv/pv) / math.Log(1+rate), nil
+}
+
+// Xfv returns the future value of the flows at the last flow date, compounding like Xnpv (days/365).
+func Xfv(rate float64, cf CashFlowTab) (fRet float64, e error) {
+	if len(cf) < 2 {
+		e = ErrParametersError
+		return
+	}
+
+	fHorizon := cf.FirstDate()
 	for _, c := range cf {
-		d := float64(c.Date.DaysFrom(fNull)) / 365.0
-		fRet += c.Flow / math.Pow(rate, d)
+		if c.Date.Date.After(fHorizon.Date) {
+			fHorizon = c.Date
+		}
+	}
+	return XfvAt(rate, cf, fHorizon)
+}
+
+// XfvAt returns the future value of the flows at horizon, compounding like Xnpv (days/365).
+// Flows after the horizon are discounted to it.
+func XfvAt(rate float64, cf CashFlowTab, horizon Date) (fRet float64, e error) {
+	if len(cf) < 2 || rate <= -1 {
+		e = ErrParametersError
+		return
 	}
+	fRet = xnpvAt(rate, cf, horizon)
 	return
 }
 
@@ -371,6 +593,62 @@ func Pmt(rate float64, nper int64, pv float64, fv float64, t bool) float64 {
 	return -(fv*rate/(term-1) + pv*rate/(1-1/term))
 }
 
+// Ipmt returns the interest part of payment number per, like Excel IPMT.
+func Ipmt(rate float64, per int64, nper int64, pv float64, fv float64, t bool) (float64, error) {
+	if per < 1 || per > nper {
+		return 0, ErrParametersError
+	}
+
+	var nPayType int64 = 0
+	if t {
+		nPayType = 1
+	}
+	fRmz := GetRmz(rate, float64(nper), pv, fv, nPayType)
+
+	var fZinsZ float64
+	if per == 1 {
+		if t {
+			fZinsZ = 0.0
+		} else {
+			fZinsZ = -pv
+		}
+	} else {
+		if t {
+			fZinsZ = GetZw(rate, float64(per-2), fRmz, pv, 1) - fRmz
+		} else {
+			fZinsZ = GetZw(rate, float64(per-1), fRmz, pv, 0)
+		}
+	}
+	return fZinsZ * rate, nil
+}
+
+// Ppmt returns the principal part of payment number per, like Excel PPMT.
+func Ppmt(rate float64, per int64, nper int64, pv float64, fv float64, t bool) (float64, error) {
+	fInterest, e := Ipmt(rate, per, nper, pv, fv, t)
+	if e != nil {
+		return 0, e
+	}
+	return Pmt(rate, nper, pv, fv, t) - fInterest, nil
+}
+
+// CumIpmt returns the interest paid between start and end periods (including), like Excel CUMIPMT.
+func CumIpmt(rate float64, nper int64, pv float64, start int64, end int64, t bool) (float64, error) {
+	var nPayType int64 = 0
+	if t {
+		nPayType = 1
+	}
+	return getCumipmt(rate, nper, pv, start, end, nPayType)
+}
+
+// CumPrinc returns the principal paid between start and end periods (including), like Excel CUMPRINC.
+func CumPrinc(rate float64, nper int64, pv float64, start int64, end int64, t bool) (float64, error) {
+	var nPayType int64 = 0
+	if t {
+		nPayType = 1
+	}
+	return getCumprinc(rate, nper, pv, start, end, nPayType)
+}
+
 // Crf is "Capital recovery factor"
 func Crf(rate float64, nper int64) (result float64, e error) {
 	if nper == 0 {
@@ -2,6 +2,7 @@ package financial
 
 import (
 	"github.com/aviplot/go-finance-math/test/testdata"
+	"math"
 	"testing"
 )
 
@@ -51,9 +52,9 @@ func TestXirr(t *testing.T) {
 	for _, td := range tdTab {
 		// Create cashflow using test data.
 		/*
-			first float64, fDate string, months int, in float64, inDate string
+			first float64, fDate string, months int, in float64, inDate string, balloon float64, balloonDate string
 		*/
-		cf := NewCashFlowTab(td.Amount, td.DateStart, td.IncomeTimes, td.Income, td.DateIncomeStart)
+		cf := NewCashFlowTabWithBalloon(td.Amount, td.DateStart, td.IncomeTimes, td.Income, td.DateIncomeStart, td.Balloon, td.BalloonDate)
 		//fmt.Println(cf)
 		expected := td.ExpectedXIRR
 		precision := getPrecisionFromFloat(expected)
@@ -111,7 +112,7 @@ func TestXnpv(t *testing.T) {
 
 	for _, td := range tdTab {
 		// Create cashflow using test data.
-		cf := NewCashFlowTab(td.Amount, td.DateStart, td.IncomeTimes, td.Income, td.DateIncomeStart)
+		cf := NewCashFlowTabWithBalloon(td.Amount, td.DateStart, td.IncomeTimes, td.Income, td.DateIncomeStart, td.Balloon, td.BalloonDate)
 		//fmt.Println(cf)
 
 		expected := td.ExpectedNPV
@@ -154,6 +155,35 @@ func TestCashFlow(t *testing.T) {
 	}
 }
 
+// TestAddFlow validate AddFlow inserts ordered by date, and does not change the tab
+func TestAddFlow(t *testing.T) {
+	ca := make(CashFlowTab, 0, 10)
+	ca = append(ca, NewCashFlowTab(-1000, "2021-01-01", 3, 400, "2021-03-01")...)
+	r := ca.AddFlow(CashFlow{NewDateFromFormattedString("2021-02-15"), 10})
+	r = r.AddFlow(CashFlow{NewDateFromFormattedString("2021-03-01"), 5})
+
+	expected := CashFlowTab{
+		{NewDateFromFormattedString("2021-01-01"), -1000},
+		{NewDateFromFormattedString("2021-02-15"), 10},
+		{NewDateFromFormattedString("2021-03-01"), 405},
+		{NewDateFromFormattedString("2021-04-01"), 400},
+		{NewDateFromFormattedString("2021-05-01"), 400},
+	}
+	if len(r) != len(expected) {
+		t.Fatalf("Error, rows: \"%v\" Expected: \"%v\"", len(r), len(expected))
+	}
+	for i := range expected {
+		if !r[i].Date.Date.Equal(expected[i].Date.Date) || r[i].Flow != expected[i].Flow {
+			t.Fatalf("Error, row %v: \"%v\" Expected: \"%v\"", i, r[i], expected[i])
+		}
+	}
+
+	// The original tab is not changed
+	if len(ca) != 4 || ca[1].Flow != 400 || !ca[1].Date.Date.Equal(NewDateFromFormattedString("2021-03-01").Date) {
+		t.Fatalf("Error, original tab changed: \"%v\"", ca)
+	}
+}
+
 // TestIrr validate IRR function
 func TestIrr(t *testing.T) {
 	// Read known data.
@@ -162,9 +192,9 @@ func TestIrr(t *testing.T) {
 	for _, td := range tdTab {
 		// Create cashflow using test data.
 		/*
-			first float64, fDate string, months int, in float64, inDate string
+			first float64, fDate string, months int, in float64, inDate string, balloon float64, balloonDate string
 		*/
-		cf := NewCashFlowTab(td.Amount, td.DateStart, td.IncomeTimes, td.Income, td.DateIncomeStart)
+		cf := NewCashFlowTabWithBalloon(td.Amount, td.DateStart, td.IncomeTimes, td.Income, td.DateIncomeStart, td.Balloon, td.BalloonDate)
 		//fmt.Println(cf)
 		expected := td.ExpectedIRR
 		precision := getPrecisionFromFloat(expected)
@@ -176,3 +206,408 @@ func TestIrr(t *testing.T) {
 		}
 	}
 }
+
+// TestRate validate RATE function
+func TestRate(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetRateTestData()
+
+	for _, td := range tdTab {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Rate(td.Nper, td.Pmt, td.Pv, td.Fv, td.Type, td.Guess)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		// Back to the payment
+		pmt := Pmt(result, td.Nper, td.Pv, td.Fv, td.Type)
+		if round(pmt, 2) != round(td.Pmt, 2) {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", pmt, td.Pmt)
+		}
+	}
+
+	// No solution, paying and getting money
+	if _, e := Rate(10, 100, 1000, 0, false, 0.1); e != ErrCalculationError {
+		t.Fatalf("Error, expected: %v got: %v", ErrCalculationError, e)
+	}
+	if _, e := Rate(0, -100, 1000, 0, false, 0.1); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestNper validate NPER function
+func TestNper(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetNperTestData()
+
+	for _, td := range tdTab {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Nper(td.Rate, td.Pmt, td.Pv, td.Fv, td.Type)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	// Payment does not cover the interest
+	if _, e := Nper(0.01, -5, 1000, 0, false); e != ErrCalculationError {
+		t.Fatalf("Error, expected: %v got: %v", ErrCalculationError, e)
+	}
+	if _, e := Nper(0, 0, 1000, 0, false); e != ErrCalculationError {
+		t.Fatalf("Error, expected: %v got: %v", ErrCalculationError, e)
+	}
+	if _, e := Nper(-1, -100, 1000, 0, false); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestIpmtPpmt validate IPMT and PPMT functions
+func TestIpmtPpmt(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetIpmtTestData()
+
+	for _, td := range tdTab {
+		expected := td.Ipmt
+		precision := getPrecisionFromFloat(expected)
+		result, e := Ipmt(td.Rate, td.Per, td.Nper, td.Pv, td.Fv, td.Type)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		expected = td.Ppmt
+		precision = getPrecisionFromFloat(expected)
+		result, e = Ppmt(td.Rate, td.Per, td.Nper, td.Pv, td.Fv, td.Type)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	if _, e := Ipmt(0.1, 4, 3, 8000, 0, false); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestCumIpmtCumPrinc validate CUMIPMT and CUMPRINC functions
+func TestCumIpmtCumPrinc(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetCumulativeTestData()
+
+	for _, td := range tdTab {
+		expected := td.CumIpmt
+		precision := getPrecisionFromFloat(expected)
+		result, e := CumIpmt(td.Rate, td.Nper, td.Pv, td.Start, td.End, td.Type)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		expected = td.CumPrinc
+		precision = getPrecisionFromFloat(expected)
+		result, e = CumPrinc(td.Rate, td.Nper, td.Pv, td.Start, td.End, td.Type)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	if _, e := CumIpmt(0.1, 10, 8000, 5, 4, false); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestMirr validate MIRR and XMIRR functions
+func TestMirr(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetMirrTestData()
+
+	for _, td := range tdTab {
+		// Yearly flows, 365 days apart, so XMirr is Mirr.
+		var cf CashFlowTab
+		d := NewDateFromFormattedString("2001-01-01")
+		for _, v := range td.Values {
+			cf = append(cf, CashFlow{d, v})
+			d = NewDateFromTime(d.Date.AddDate(0, 0, 365))
+		}
+
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+		result, e := Mirr(cf, td.FinanceRate, td.ReinvestRate)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		result, e = XMirr(cf, td.FinanceRate, td.ReinvestRate)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	cf := NewCashFlowTab(1000, "2001-01-01", 5, 100, "2001-02-01")
+	if _, e := Mirr(cf, 0.1, 0.1); e != ErrCalculationError {
+		t.Fatalf("Error, expected: %v got: %v", ErrCalculationError, e)
+	}
+	if _, e := XMirr(cf, 0.1, 0.1); e != ErrCalculationError {
+		t.Fatalf("Error, expected: %v got: %v", ErrCalculationError, e)
+	}
+}
+
+// TestNpv validate NPV functions, and compare them to Xnpv
+func TestNpv(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetNpvTestData()
+
+	for _, td := range tdTab {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+		result, e := Npv(td.Rate, td.Values)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		if round(result, precision) != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		// Yearly flows, 365 days apart.
+		var cf CashFlowTab
+		d := NewDateFromFormattedString("2001-01-01")
+		for _, v := range td.Values {
+			cf = append(cf, CashFlow{d, v})
+			d = NewDateFromTime(d.Date.AddDate(0, 0, 365))
+		}
+		xnpv, _ := Xnpv(td.Rate, cf)
+		fromZero, e := NpvFromTimeZero(td.Rate, cf.Flows())
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		if round(fromZero, 8) != round(xnpv, 8) {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", fromZero, xnpv)
+		}
+		if round(result*(1+td.Rate), 8) != round(xnpv, 8) {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result*(1+td.Rate), xnpv)
+		}
+		rest, _ := Npv(td.Rate, td.Values[1:])
+		if round(rest+td.Values[0], 8) != round(fromZero, 8) {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", rest+td.Values[0], fromZero)
+		}
+	}
+
+	if _, e := Npv(0.1, nil); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestXirrWithOptions validate XIRR options, and the bisection fallback
+func TestXirrWithOptions(t *testing.T) {
+	// Default options are Xirr
+	tdTab := testdata.TESTGetCashflowTestData()
+	for _, td := range tdTab {
+		cf := NewCashFlowTabWithBalloon(td.Amount, td.DateStart, td.IncomeTimes, td.Income, td.DateIncomeStart, td.Balloon, td.BalloonDate)
+		expected, _ := Xirr(cf)
+		result, e := XirrWithOptions(cf, DefaultXirrOptions())
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result, expected)
+		}
+	}
+
+	// Distressed asset, Xirr fails
+	cf := CashFlowTab{
+		{NewDateFromFormattedString("2021-01-01"), -100000},
+		{NewDateFromFormattedString("2021-07-01"), 20},
+		{NewDateFromFormattedString("2022-01-01"), 30},
+	}
+	if _, e := Xirr(cf); e != ErrCalculationError {
+		t.Fatalf("Error, expected: %v got: %v", ErrCalculationError, e)
+	}
+	expected := -0.9996966306
+	precision := getPrecisionFromFloat(expected)
+	result, e := XirrWithOptions(cf, DefaultXirrOptions())
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	result = round(result, precision)
+	if result != expected {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+	}
+
+	// Short dated trade, with too few iterations for Newton's method
+	cf = CashFlowTab{
+		{NewDateFromFormattedString("2021-01-01"), -100},
+		{NewDateFromFormattedString("2021-01-16"), 100},
+		{NewDateFromFormattedString("2021-01-31"), 100},
+	}
+	o := DefaultXirrOptions()
+	o.MaxIterations = 3
+	o.MaxScans = 1
+	if _, e = xirrNewton(cf, o); e != ErrCalculationError {
+		t.Fatalf("Error, expected: %v got: %v", ErrCalculationError, e)
+	}
+	expected = 121720.112417
+	precision = getPrecisionFromFloat(expected)
+	result, e = XirrWithOptions(cf, o)
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	result = round(result, precision)
+	if result != expected {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+	}
+
+	// Rate is out of the allowed range
+	o = DefaultXirrOptions()
+	o.MaxRate = 1000
+	if _, e = XirrWithOptions(cf, o); e != ErrCalculationError {
+		t.Fatalf("Error, expected: %v got: %v", ErrCalculationError, e)
+	}
+	o.Tolerance = 0
+	if _, e = XirrWithOptions(cf, o); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestXfv validate Xfv is Xnpv compounded to the horizon
+func TestXfv(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetCashflowTestData()
+
+	for _, td := range tdTab {
+		cf := NewCashFlowTabWithBalloon(td.Amount, td.DateStart, td.IncomeTimes, td.Income, td.DateIncomeStart, td.Balloon, td.BalloonDate)
+		xnpv, _ := Xnpv(td.Rate, cf)
+
+		last := cf[len(cf)-1].Date
+		expected := xnpv * math.Pow(1+td.Rate, float64(last.DaysFrom(cf.FirstDate()))/365)
+		result, e := Xfv(td.Rate, cf)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		if round(result, 6) != round(expected, 6) {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result, expected)
+		}
+
+		horizon := NewDateFromFormattedString("2030-01-01")
+		expected = xnpv * math.Pow(1+td.Rate, float64(horizon.DaysFrom(cf.FirstDate()))/365)
+		result, e = XfvAt(td.Rate, cf, horizon)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		if round(result, 6) != round(expected, 6) {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result, expected)
+		}
+	}
+
+	// One deposit, a year later
+	cf := CashFlowTab{{NewDateFromFormattedString("2021-01-01"), 1000}, {NewDateFromFormattedString("2022-01-01"), 0}}
+	result, _ := Xfv(0.05, cf)
+	if round(result, 6) != 1050 {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result, 1050)
+	}
+}
+
+// TestFvSchedule validate FVSCHEDULE function
+func TestFvSchedule(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetFvScheduleTestData()
+
+	for _, td := range tdTab {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result := round(FvSchedule(td.Principal, td.Rates), precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+}
+
+// TestRriPduration validate RRI and PDURATION functions
+func TestRriPduration(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetRriTestData()
+
+	for _, td := range tdTab {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Rri(td.Nper, td.Pv, td.Fv)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	tdTab = testdata.TESTGetPdurationTestData()
+	for _, td := range tdTab {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Pduration(td.Rate, td.Pv, td.Fv)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		// Rri over the duration returns the rate back
+		n, _ := Pduration(td.Rate, td.Pv, td.Fv)
+		rate, _ := Rri(n, td.Pv, td.Fv)
+		if round(rate, 9) != round(td.Rate, 9) {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", rate, td.Rate)
+		}
+	}
+
+	if _, e := Rri(0, 1000, 2000); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Rri(10, 0, 2000); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Rri(2.5, -1000, 2000); e != ErrCalculationError {
+		t.Fatalf("Error, expected: %v got: %v", ErrCalculationError, e)
+	}
+	if _, e := Pduration(0, 1000, 2000); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Pduration(0.05, -1000, 2000); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
@@ -0,0 +1,23 @@
+package financial
+
+type GraceType int
+
+const (
+	FullDeferral GraceType = iota + 1 // Nothing is paid, interest is added to the balance
+	InterestOnly                      // Only the interest is paid
+)
+
+// GracePeriod is the beginning of a loan, where the principal is not paid.
+// Periods are part of the loan periods, the balance is amortized over the remaining periods.
+type GracePeriod struct {
+	Periods int64
+	Type    GraceType
+}
+
+// valid returns false for negative periods, or unknown type.
+func (g GracePeriod) valid() bool {
+	if g.Periods == 0 {
+		return true
+	}
+	return g.Periods > 0 && (g.Type == FullDeferral || g.Type == InterestOnly)
+}
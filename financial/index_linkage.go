@@ -0,0 +1,92 @@
+package financial
+
+import (
+	"math"
+	"sort"
+)
+
+// IndexValue is a published value of an index (CPI), known from Date.
+type IndexValue struct {
+	Date  Date    `json:"date"`
+	Value float64 `json:"value"`
+}
+
+// IndexTab is just slice of IndexValue.
+type IndexTab []IndexValue
+
+// IndexLinkage links a loan to an index, the balance is revalued by the index change every period.
+type IndexLinkage struct {
+	Base      float64  // Index value known at BaseDate (the loan date)
+	BaseDate  Date     // Date of Base, required and not after the first payment
+	Values    IndexTab // Index values known after BaseDate
+	Inflation float64  // Assumed annual inflation, projects the index after the last known value
+}
+
+// linked returns true when there is an index to link to.
+func (il IndexLinkage) linked() bool {
+	return il.Base != 0
+}
+
+// valid returns false for non positive index values, inflation of -100% or less,
+// or missing BaseDate (or after the first payment).
+func (il IndexLinkage) valid(firstPayment Date) bool {
+	if !il.linked() {
+		return true
+	}
+	if il.Base < 0 || il.Inflation <= -1 || il.BaseDate.Date.IsZero() || il.BaseDate.Date.After(firstPayment.Date) {
+		return false
+	}
+	for _, v := range il.Values {
+		if v.Value <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ValueAt returns the index known at d, which is the last value published up to d.
+// When d is after the last published value, the index is projected using the assumed inflation.
+func (il IndexLinkage) ValueAt(d Date) float64 {
+	return il.ordered().valueAt(d)
+}
+
+// ordered returns a copy of the linkage, with the values ordered by date.
+func (il IndexLinkage) ordered() IndexLinkage {
+	il.Values = il.Values.OrderByDate()
+	return il
+}
+
+// valueAt is ValueAt, the values are expected to be ordered by date.
+func (il IndexLinkage) valueAt(d Date) float64 {
+	known := IndexValue{il.BaseDate, il.Base}
+	for _, v := range il.Values {
+		if v.Date.Date.After(d.Date) {
+			return known.Value
+		}
+		known = v
+	}
+	years := float64(d.DaysFrom(known.Date)) / 365.0
+	return known.Value * math.Pow(1+il.Inflation, years)
+}
+
+// Len impl "Interface" to support sorting, using sort.Sort.
+func (it IndexTab) Len() int {
+	return len(it)
+}
+
+// Swap impl "Interface" to support sorting, using sort.Sort.
+func (it IndexTab) Swap(i, j int) {
+	it[i], it[j] = it[j], it[i]
+}
+
+// Less impl "Interface" to support sorting, using sort.Sort.
+func (it IndexTab) Less(i, j int) bool {
+	return it[i].Date.Date.Before(it[j].Date.Date)
+}
+
+// OrderByDate returns sorted copy of the tab.
+func (it IndexTab) OrderByDate() (r IndexTab) {
+	r = append(r, it...)
+	sort.Sort(r)
+	return
+}
@@ -0,0 +1,235 @@
+package financial
+
+import (
+	"errors"
+	"math"
+)
+
+// periodsPerYear is the amount of payments in a year, loans are paid monthly.
+const periodsPerYear = 12
+
+var ErrUnknownCalculationType = errors.New("unknown calculation type")
+
+// Loan holds the terms of a loan, paid monthly.
+type Loan struct {
+	Principal    float64         // Amount lent
+	Rate         float64         // Annual rate, the monthly rate is Rate / 12
+	Periods      int64           // Number of monthly payments
+	FirstPayment Date            // Date of the first payment, next payments are one month apart (on the same day, or the end of month)
+	Method       CalculationType // Shpitzer (same payment) or SameFromPrincipal (same principal)
+	Balloon      float64         // Part of the principal paid with the last payment (at maturity only), zero if none
+	Grace        GracePeriod     // Periods at the beginning without principal payments
+	Index        IndexLinkage    // Index (CPI) the loan is linked to, zero value if not linked
+	Resets       RateResetTab    // Rate changes (or base rate series), Rate is used until the first reset
+	Margin       float64         // Added to the rates of Resets
+
+	Prepayments    PrepaymentTab  // Paid on the first payment date, on or after their date, up to the last payment
+	PrepaymentMode PrepaymentMode // What is reduced after a prepayment, the payment or the term
+}
+
+// NewAmortizationSchedule returns the payments schedule of a loan.
+func NewAmortizationSchedule(principal float64, rate float64, periods int64, firstPayment Date, ct CalculationType) (calculatedCashFlowTab, error) {
+	l := Loan{
+		Principal:    principal,
+		Rate:         rate,
+		Periods:      periods,
+		FirstPayment: firstPayment,
+		Method:       ct,
+	}
+	return l.Schedule()
+}
+
+// NewBalloonSchedule returns the payments schedule of a loan, where balloon is paid with the last payment.
+// Balloons are paid only at maturity, use NewCashFlowTabWithBalloon for a balloon on another date.
+func NewBalloonSchedule(principal float64, rate float64, periods int64, firstPayment Date, ct CalculationType, balloon float64) (calculatedCashFlowTab, error) {
+	l := Loan{
+		Principal:    principal,
+		Rate:         rate,
+		Periods:      periods,
+		FirstPayment: firstPayment,
+		Method:       ct,
+		Balloon:      balloon,
+	}
+	return l.Schedule()
+}
+
+// NewBulletSchedule returns the payments schedule of interest only loan, all the principal is paid at maturity.
+func NewBulletSchedule(principal float64, rate float64, periods int64, firstPayment Date) (calculatedCashFlowTab, error) {
+	return NewBalloonSchedule(principal, rate, periods, firstPayment, Shpitzer, principal)
+}
+
+// NewGraceSchedule returns the payments schedule of a loan, starting with grace period.
+func NewGraceSchedule(principal float64, rate float64, periods int64, firstPayment Date, ct CalculationType, g GracePeriod) (calculatedCashFlowTab, error) {
+	l := Loan{
+		Principal:    principal,
+		Rate:         rate,
+		Periods:      periods,
+		FirstPayment: firstPayment,
+		Method:       ct,
+		Grace:        g,
+	}
+	return l.Schedule()
+}
+
+// NewIndexLinkedSchedule returns the payments schedule of a loan linked to an index (CPI).
+func NewIndexLinkedSchedule(principal float64, rate float64, periods int64, firstPayment Date, ct CalculationType, index IndexLinkage) (calculatedCashFlowTab, error) {
+	l := Loan{
+		Principal:    principal,
+		Rate:         rate,
+		Periods:      periods,
+		FirstPayment: firstPayment,
+		Method:       ct,
+		Index:        index,
+	}
+	return l.Schedule()
+}
+
+// NewVariableRateSchedule returns the payments schedule of a variable rate loan.
+// Resets are new rates or base rates (like prime), the loan rate is the reset rate plus margin.
+// Rate is used until the first reset.
+func NewVariableRateSchedule(principal float64, rate float64, periods int64, firstPayment Date, ct CalculationType, resets RateResetTab, margin float64) (calculatedCashFlowTab, error) {
+	l := Loan{
+		Principal:    principal,
+		Rate:         rate,
+		Periods:      periods,
+		FirstPayment: firstPayment,
+		Method:       ct,
+		Resets:       resets,
+		Margin:       margin,
+	}
+	return l.Schedule()
+}
+
+// RateAt returns the annual rate of the loan at d.
+func (l Loan) RateAt(d Date) float64 {
+	return l.rateAt(l.Resets.OrderByDate(), d)
+}
+
+// rateAt is RateAt, resets are expected to be ordered by date.
+func (l Loan) rateAt(resets RateResetTab, d Date) float64 {
+	if r, found := resets.rateAt(d); found {
+		return r + l.Margin
+	}
+	return l.Rate
+}
+
+// Schedule calculates the loan, row by row.
+// Flows of the rows are the payments, positive from the lender side (like NewCashFlowPayments).
+// When linked to an index, the balance (and the payment) is revalued by the index change before calculating the period.
+// When the rate is reset, the payment is recalculated over the remaining periods.
+// Prepayments reduce the balance after the payment, then the payment or the term are reduced (see PrepaymentMode).
+func (l Loan) Schedule() (result calculatedCashFlowTab, e error) {
+	if l.Principal <= 0 || l.Rate < 0 || l.Periods <= 0 || l.Balloon < 0 || l.Balloon > l.Principal ||
+		!l.Grace.valid() || l.Grace.Periods >= l.Periods || !l.Index.valid(l.FirstPayment) ||
+		!l.Prepayments.valid(l.PrepaymentMode, l.FirstPayment.AddMonths(int(l.Periods-1))) {
+		e = ErrParametersError
+		return
+	}
+	for _, r := range l.Resets {
+		if r.Rate+l.Margin < 0 {
+			e = ErrParametersError
+			return
+		}
+	}
+
+	if l.Method != Shpitzer && l.Method != SameFromPrincipal {
+		e = ErrUnknownCalculationType
+		return
+	}
+
+	var rate, payment, principal float64
+	balance := l.Principal
+	balloon := l.Balloon
+	recalculate := true
+	// Sorted once, not on every period
+	linkage := l.Index.ordered()
+	resets := l.Resets.OrderByDate()
+	index := linkage.Base
+	end := l.Periods
+	var previous Date
+
+	for i := int64(1); i <= end; i++ {
+		// Dates are counted from the first payment, so they do not drift on the end of month
+		d := l.FirstPayment.AddMonths(int(i - 1))
+		c := NewEmptyCalculatedCashFlow()
+		c.Date = d
+		c.OrderId = int(i)
+		c.Period = int(i)
+		c.OpeningBalance = balance
+		if r := l.rateAt(resets, d) / periodsPerYear; r != rate {
+			rate = r
+			recalculate = true
+		}
+		if l.Index.linked() {
+			// Revalue by the index change, since the previous period.
+			currentIndex := linkage.valueAt(d)
+			change := currentIndex / index
+			index = currentIndex
+			c.Linkage = balance * (change - 1)
+			balance += c.Linkage
+			balloon *= change
+			payment *= change
+			principal *= change
+		}
+		c.Interest = balance * rate
+		switch {
+		case i <= l.Grace.Periods && l.Grace.Type == FullDeferral:
+			// Interest is added to the balance.
+			c.Principal = -c.Interest
+		case i <= l.Grace.Periods:
+			c.Principal = 0
+		default:
+			if recalculate {
+				// Amortize the balance over the remaining periods.
+				remaining := end - i + 1
+				switch l.Method {
+				case Shpitzer:
+					// Same payment every period.
+					payment = Pmt(rate, remaining, -balance, balloon, false)
+				case SameFromPrincipal:
+					// Same principal every period, interest is paid on the balance.
+					principal = (balance - balloon) / float64(remaining)
+				}
+				recalculate = false
+			}
+			if l.Method == Shpitzer {
+				c.Principal = payment - c.Interest
+			} else {
+				c.Principal = principal
+			}
+			if i == end || c.Principal > balance {
+				// Last payment closes the loan (including the balloon), neglecting floating point leftovers.
+				c.Principal = balance
+			}
+		}
+		balance -= c.Principal
+
+		c.Prepayment = math.Min(l.Prepayments.amountBetween(previous, d), balance)
+		if c.Prepayment > 0 {
+			balance -= c.Prepayment
+			switch {
+			case balance == 0:
+				// Loan is repaid.
+			case l.PrepaymentMode == ReducePayment || i <= l.Grace.Periods || balloon != 0:
+				recalculate = true
+			case l.Method == Shpitzer:
+				var left int64
+				if left, e = periodsLeft(rate, payment, balance); e != nil {
+					return nil, e
+				}
+				end = i + left
+			default:
+				end = i + int64(math.Ceil(round(balance/principal, 9)))
+			}
+		}
+		c.Flow = c.Interest + c.Principal + c.Prepayment
+		c.ClosingBalance = balance
+
+		result = append(result, c)
+		if balance == 0 {
+			break
+		}
+		previous = d
+	}
+	return
+}
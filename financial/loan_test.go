@@ -0,0 +1,656 @@
+package financial
+
+import (
+	"github.com/aviplot/go-finance-math/test/testdata"
+	"math"
+	"testing"
+)
+
+// TestShpitzerSchedule validate annuity schedule against Pmt, getCumipmt and getCumprinc
+func TestShpitzerSchedule(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetLoanTestData()
+
+	for _, td := range tdTab {
+		s, e := NewAmortizationSchedule(td.Principal, td.Rate, td.Periods, NewDateFromFormattedString(td.FirstPayment), Shpitzer)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		if int64(len(s)) != td.Periods {
+			t.Fatalf("Error, rows: \"%v\" Expected: \"%v\"", len(s), td.Periods)
+		}
+
+		rate := td.Rate / 12
+		pmt := Pmt(rate, td.Periods, -td.Principal, 0, false)
+		for i, c := range s {
+			if round(c.Flow, 6) != round(pmt, 6) {
+				t.Fatalf("Error, period: %v payment: \"%v\" Expected: \"%v\"", c.Period, c.Flow, pmt)
+			}
+			if round(c.OpeningBalance-c.Principal, 6) != round(c.ClosingBalance, 6) {
+				t.Fatalf("Error, period: %v balance does not reconcile", c.Period)
+			}
+
+			cumI, _ := getCumipmt(rate, td.Periods, td.Principal, 1, int64(i+1), 0)
+			cumP, _ := getCumprinc(rate, td.Periods, td.Principal, 1, int64(i+1), 0)
+			if round(s[:i+1].TotalInterest(), 4) != round(-cumI, 4) {
+				t.Fatalf("Error, period: %v interest: \"%v\" Expected: \"%v\"", c.Period, s[:i+1].TotalInterest(), -cumI)
+			}
+			if round(s[:i+1].TotalPrincipal(), 4) != round(-cumP, 4) {
+				t.Fatalf("Error, period: %v principal: \"%v\" Expected: \"%v\"", c.Period, s[:i+1].TotalPrincipal(), -cumP)
+			}
+		}
+
+		expected := td.Payment
+		precision := getPrecisionFromFloat(expected)
+		result := round(s[0].Flow, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		expected = td.TotalInterest
+		precision = getPrecisionFromFloat(expected)
+		result = round(s.TotalInterest(), precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		if s[len(s)-1].ClosingBalance != 0 {
+			t.Fatalf("Error, closing balance: \"%v\" Expected: 0", s[len(s)-1].ClosingBalance)
+		}
+	}
+}
+
+// TestSameFromPrincipalSchedule validate equal principal schedule and its conversion to CashFlowTab
+func TestSameFromPrincipalSchedule(t *testing.T) {
+	// Read known data.
+	tdTab := testdata.TESTGetLoanTestData()
+
+	for _, td := range tdTab {
+		s, e := NewAmortizationSchedule(td.Principal, td.Rate, td.Periods, NewDateFromFormattedString(td.FirstPayment), SameFromPrincipal)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		for _, c := range s {
+			if round(c.Principal, 6) != round(td.Principal/float64(td.Periods), 6) {
+				t.Fatalf("Error, period: %v principal: \"%v\" Expected: \"%v\"", c.Period, c.Principal, td.Principal/float64(td.Periods))
+			}
+			if round(c.Interest, 6) != round(c.OpeningBalance*td.Rate/12, 6) {
+				t.Fatalf("Error, period: %v interest: \"%v\" Expected: \"%v\"", c.Period, c.Interest, c.OpeningBalance*td.Rate/12)
+			}
+		}
+
+		expected := td.EqualPrincipalFirst
+		precision := getPrecisionFromFloat(expected)
+		result := round(s[0].Flow, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		expected = td.EqualPrincipalInterest
+		precision = getPrecisionFromFloat(expected)
+		result = round(s.TotalInterest(), precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+
+		cf := s.CashFlowTab(NewDateFromFormattedString(td.LoanDate))
+		if len(cf) != len(s)+1 || cf.FirstFlow() != -td.Principal {
+			t.Fatalf("Error, bad conversion to CashFlowTab: %v", cf)
+		}
+		expected = td.EqualPrincipalXirr
+		precision = getPrecisionFromFloat(expected)
+		result, e = Xirr(cf)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+		npv, _ := Xnpv(result, cf)
+		if round(npv/td.Principal, 4) != 0 {
+			t.Fatalf("Error, Xnpv at Xirr: \"%v\" Expected: 0", npv)
+		}
+	}
+}
+
+// TestScheduleCashFlowTab validate Shpitzer schedule matches NewCashFlowPayments
+func TestScheduleCashFlowTab(t *testing.T) {
+	r := 0.12345
+	expected := NewCashFlowPayments(1000000, "2010-05-10", 240, r/12)
+	s, e := NewAmortizationSchedule(1000000, r, 240, NewDateFromFormattedString("2010-06-10"), Shpitzer)
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	result := s.CashFlowTab(NewDateFromFormattedString("2010-05-10"))
+	if len(result) != len(expected) {
+		t.Fatalf("Error, result: \"%v\" rows, Expected: \"%v\" rows", len(result), len(expected))
+	}
+	for i := range result {
+		if !result[i].Date.Date.Equal(expected[i].Date.Date) || round(result[i].Flow, 6) != round(expected[i].Flow, 6) {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result[i], expected[i])
+		}
+	}
+
+	if _, e = NewAmortizationSchedule(1000000, r, 240, NewDateFromFormattedString("2010-06-10"), CalculationType(0)); e != ErrUnknownCalculationType {
+		t.Fatalf("Error, expected: %v got: %v", ErrUnknownCalculationType, e)
+	}
+}
+
+// TestBalloonSchedule validate balloon loans against NewCashFlowPaymentsWithBalloon
+func TestBalloonSchedule(t *testing.T) {
+	r := 0.12345
+	balloon := 300000.0
+	expected := NewCashFlowPaymentsWithBalloon(1000000, "2010-05-10", 240, r/12, balloon)
+	for _, ct := range []CalculationType{Shpitzer, SameFromPrincipal} {
+		s, e := NewBalloonSchedule(1000000, r, 240, NewDateFromFormattedString("2010-06-10"), ct, balloon)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		// The balloon is carried to the end, and paid on top of the regular payment
+		last := s[len(s)-1]
+		if last.ClosingBalance != 0 {
+			t.Fatalf("Error, last row: \"%v\" balloon: \"%v\"", last, balloon)
+		}
+		if round(s[len(s)-2].ClosingBalance-balloon, 6) <= 0 {
+			t.Fatalf("Error, balloon paid before the last row")
+		}
+		switch ct {
+		case Shpitzer:
+			if round(last.Flow, 6) != round(s[0].Flow+balloon, 6) {
+				t.Fatalf("Error, last payment: \"%v\" Expected: \"%v\"", last.Flow, s[0].Flow+balloon)
+			}
+		case SameFromPrincipal:
+			if round(last.Principal, 6) != round(s[0].Principal+balloon, 6) {
+				t.Fatalf("Error, last principal: \"%v\" Expected: \"%v\"", last.Principal, s[0].Principal+balloon)
+			}
+		}
+		if ct != Shpitzer {
+			continue
+		}
+		result := s.CashFlowTab(NewDateFromFormattedString("2010-05-10"))
+		for i := range result {
+			if !result[i].Date.Date.Equal(expected[i].Date.Date) || round(result[i].Flow, 6) != round(expected[i].Flow, 6) {
+				t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result[i], expected[i])
+			}
+		}
+	}
+
+	if _, e := NewBalloonSchedule(1000, r, 12, NewDateFromFormattedString("2010-06-10"), Shpitzer, 1001); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestBulletSchedule validate interest only loan
+func TestBulletSchedule(t *testing.T) {
+	s, e := NewBulletSchedule(120000, 0.06, 36, NewDateFromFormattedString("2020-01-15"))
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	for _, c := range s[:len(s)-1] {
+		if round(c.Flow, 6) != 600 || round(c.Principal, 6) != 0 {
+			t.Fatalf("Error, row: \"%v\" Expected interest only payment of 600", c)
+		}
+	}
+	last := s[len(s)-1]
+	if round(last.Flow, 6) != 120600 || round(last.Principal, 6) != 120000 {
+		t.Fatalf("Error, row: \"%v\" Expected payment of 120600", last)
+	}
+	result, _ := Xirr(s.CashFlowTab(NewDateFromFormattedString("2019-12-15")))
+	if round(result, 2) != 0.06 {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result, 0.06)
+	}
+}
+
+// TestGraceSchedule validate grace periods, for both calculation types
+func TestGraceSchedule(t *testing.T) {
+	const (
+		principal = 500000.0
+		rate      = 0.06
+		periods   = 120
+		grace     = 12
+	)
+	r := rate / 12
+	first := NewDateFromFormattedString("2021-03-01")
+
+	for _, gt := range []GraceType{FullDeferral, InterestOnly} {
+		for _, ct := range []CalculationType{Shpitzer, SameFromPrincipal} {
+			s, e := NewGraceSchedule(principal, rate, periods, first, ct, GracePeriod{grace, gt})
+			if e != nil {
+				t.Fatalf("Error: %v", e)
+			}
+			if len(s) != periods {
+				t.Fatalf("Error, rows: \"%v\" Expected: \"%v\"", len(s), periods)
+			}
+
+			balance := principal
+			if gt == FullDeferral {
+				balance = Fv(r, grace, 0, -principal, false)
+			}
+			for _, c := range s[:grace] {
+				expected := c.Interest
+				if gt == FullDeferral {
+					expected = 0
+				}
+				if round(c.Flow, 6) != round(expected, 6) {
+					t.Fatalf("Error, grace row: \"%v\" Expected payment: \"%v\"", c, expected)
+				}
+			}
+			if round(s[grace-1].ClosingBalance, 6) != round(balance, 6) {
+				t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", s[grace-1].ClosingBalance, balance)
+			}
+
+			c := s[grace]
+			expected := Pmt(r, periods-grace, -balance, 0, false)
+			if ct == SameFromPrincipal {
+				expected = balance/(periods-grace) + balance*r
+			}
+			if round(c.Flow, 6) != round(expected, 6) {
+				t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", c.Flow, expected)
+			}
+			if s[len(s)-1].ClosingBalance != 0 {
+				t.Fatalf("Error, closing balance: \"%v\" Expected: 0", s[len(s)-1].ClosingBalance)
+			}
+		}
+	}
+
+	if _, e := NewGraceSchedule(principal, rate, periods, first, Shpitzer, GracePeriod{periods, InterestOnly}); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := NewGraceSchedule(principal, rate, periods, first, Shpitzer, GracePeriod{grace, 0}); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestIndexLinkedSchedule validate that linked schedule, in real terms, is the non linked schedule
+func TestIndexLinkedSchedule(t *testing.T) {
+	const (
+		principal = 800000.0
+		rate      = 0.035
+		periods   = 240
+	)
+	loanDate := NewDateFromFormattedString("2019-12-10")
+	first := loanDate.AddMonth()
+
+	// Known values for the first year, projected with 2% inflation afterwards.
+	index := IndexLinkage{Base: 100, BaseDate: loanDate, Inflation: 0.02}
+	d := first
+	for i := 1; i <= 12; i++ {
+		index.Values = append(index.Values, IndexValue{d, 100 + 0.25*float64(i)})
+		d = d.AddMonth()
+	}
+	if index.ValueAt(first) != 100.25 || index.ValueAt(loanDate) != 100 {
+		t.Fatalf("Error, wrong known index values")
+	}
+	last := index.Values[len(index.Values)-1]
+	expectedIndex := 103 * math.Pow(1.02, float64(d.DaysFrom(last.Date))/365)
+	if round(index.ValueAt(d), 10) != round(expectedIndex, 10) {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", index.ValueAt(d), expectedIndex)
+	}
+
+	for _, ct := range []CalculationType{Shpitzer, SameFromPrincipal} {
+		expected, _ := NewAmortizationSchedule(principal, rate, periods, first, ct)
+		s, e := NewIndexLinkedSchedule(principal, rate, periods, first, ct, index)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		for i, c := range s {
+			change := index.ValueAt(c.Date) / index.Base
+			if round(c.Flow/change, 6) != round(expected[i].Flow, 6) {
+				t.Fatalf("Error, period: %v real payment: \"%v\" Expected: \"%v\"", c.Period, c.Flow/change, expected[i].Flow)
+			}
+			if round(c.OpeningBalance+c.Linkage-c.Principal, 6) != round(c.ClosingBalance, 6) {
+				t.Fatalf("Error, period: %v balance does not reconcile", c.Period)
+			}
+		}
+		if s[len(s)-1].ClosingBalance != 0 {
+			t.Fatalf("Error, closing balance: \"%v\" Expected: 0", s[len(s)-1].ClosingBalance)
+		}
+		if round(s.TotalPrincipal()-s.TotalLinkage(), 6) != principal {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", s.TotalPrincipal()-s.TotalLinkage(), principal)
+		}
+	}
+
+	// BaseDate is required, and must not be after the first payment.
+	for _, baseDate := range []Date{{}, first.AddMonth()} {
+		bad := IndexLinkage{Base: 100, BaseDate: baseDate, Inflation: 0.02}
+		if _, e := NewIndexLinkedSchedule(principal, rate, periods, first, Shpitzer, bad); e != ErrParametersError {
+			t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+		}
+	}
+
+	index.Values = append(index.Values, IndexValue{d, -1})
+	if _, e := NewIndexLinkedSchedule(principal, rate, periods, first, Shpitzer, index); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestVariableRateSchedule validate payment recalculation at rate resets
+func TestVariableRateSchedule(t *testing.T) {
+	const (
+		principal = 400000.0
+		periods   = 180
+	)
+	first := NewDateFromFormattedString("2021-01-05")
+	// Prime series, loan is prime + 1.5%
+	prime := RateResetTab{
+		{NewDateFromFormattedString("2026-01-05"), 0.06},
+		{NewDateFromFormattedString("2022-06-01"), 0.04},
+	}
+	resets := RateResetTab{
+		{NewDateFromFormattedString("2022-06-01"), 0.055},
+		{NewDateFromFormattedString("2026-01-05"), 0.075},
+	}
+
+	for _, ct := range []CalculationType{Shpitzer, SameFromPrincipal} {
+		s, e := NewVariableRateSchedule(principal, 0.03, periods, first, ct, prime, 0.015)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		expected, _ := NewVariableRateSchedule(principal, 0.03, periods, first, ct, resets, 0)
+		for i, c := range s {
+			if round(c.Flow, 6) != round(expected[i].Flow, 6) {
+				t.Fatalf("Error, period: %v result: \"%v\" Expected: \"%v\"", c.Period, c.Flow, expected[i].Flow)
+			}
+
+			rate, found := resets.RateAt(c.Date)
+			if !found {
+				rate = 0.03
+			}
+			if round(c.Interest, 6) != round(c.OpeningBalance*rate/12, 6) {
+				t.Fatalf("Error, period: %v interest: \"%v\" Expected: \"%v\"", c.Period, c.Interest, c.OpeningBalance*rate/12)
+			}
+			if ct != Shpitzer || i == 0 || i == len(s)-1 {
+				continue
+			}
+			payment := Pmt(rate/12, int64(periods-i), -c.OpeningBalance, 0, false)
+			if round(c.Flow, 6) != round(payment, 6) {
+				t.Fatalf("Error, period: %v payment: \"%v\" Expected: \"%v\"", c.Period, c.Flow, payment)
+			}
+		}
+		if s[len(s)-1].ClosingBalance != 0 {
+			t.Fatalf("Error, closing balance: \"%v\" Expected: 0", s[len(s)-1].ClosingBalance)
+		}
+
+		result, e := Xirr(s.CashFlowTab(NewDateFromFormattedString("2020-12-05")))
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		if result < 0.03 || result > 0.08 {
+			t.Fatalf("Error, result: \"%v\" Expected between the lowest and highest rates", result)
+		}
+	}
+
+	if _, e := NewVariableRateSchedule(principal, 0.03, periods, first, Shpitzer, prime, -0.05); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestPrepayment validate both prepayment modes
+func TestPrepayment(t *testing.T) {
+	const (
+		principal = 300000.0
+		rate      = 0.05
+		periods   = 240
+		amount    = 50000.0
+	)
+	r := rate / 12
+	first := NewDateFromFormattedString("2020-01-10")
+	l := Loan{
+		Principal:    principal,
+		Rate:         rate,
+		Periods:      periods,
+		FirstPayment: first,
+		Method:       Shpitzer,
+	}
+	// Paid with the 24th payment.
+	p := PrepaymentTab{{NewDateFromFormattedString("2021-12-01"), amount}}
+
+	tests := []struct {
+		method CalculationType
+		mode   PrepaymentMode
+		rows   int
+	}{
+		{Shpitzer, ReducePayment, periods},
+		{Shpitzer, ReduceTerm, 185},
+		{SameFromPrincipal, ReducePayment, periods},
+		{SameFromPrincipal, ReduceTerm, 200},
+	}
+	for _, tt := range tests {
+		l.Method = tt.method
+		result, e := l.Prepay(p, tt.mode)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		s := result.Revised
+		if len(s) != tt.rows || result.PeriodsSaved != periods-tt.rows {
+			t.Fatalf("Error, rows: \"%v\" Expected: \"%v\"", len(s), tt.rows)
+		}
+		if s[23].Prepayment != amount || round(s.TotalPrepayments(), 6) != amount {
+			t.Fatalf("Error, prepayment: \"%v\" Expected: \"%v\"", s[23].Prepayment, amount)
+		}
+		if round(s.TotalPrincipal()+s.TotalPrepayments(), 6) != principal || s[len(s)-1].ClosingBalance != 0 {
+			t.Fatalf("Error, loan is not repaid")
+		}
+		expected := round(result.Original.TotalInterest()-s.TotalInterest(), 6)
+		if expected <= 0 || round(result.InterestSaved, 6) != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", result.InterestSaved, expected)
+		}
+
+		c := s[24]
+		expected = result.Original[24].Flow
+		switch {
+		case tt.method == Shpitzer && tt.mode == ReducePayment:
+			expected = Pmt(r, periods-24, -c.OpeningBalance, 0, false)
+		case tt.method == SameFromPrincipal && tt.mode == ReducePayment:
+			expected = c.OpeningBalance/(periods-24) + c.Interest
+		case tt.method == SameFromPrincipal:
+			expected = principal/periods + c.Interest
+		}
+		if round(c.Flow, 6) != round(expected, 6) {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", c.Flow, expected)
+		}
+	}
+
+	// Prepaying everything ends the loan.
+	result, e := l.Prepay(PrepaymentTab{{first, principal}}, ReduceTerm)
+	if e != nil || len(result.Revised) != 1 || result.Revised[0].ClosingBalance != 0 {
+		t.Fatalf("Error, loan is not repaid: %v %v", e, result.Revised)
+	}
+
+	if _, e = l.Prepay(p, 0); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	// A prepayment after the last payment can't be paid.
+	late := PrepaymentTab{{first.AddMonths(periods), amount}}
+	if _, e = l.Prepay(late, ReduceTerm); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+
+	// A payment that does not cover the interest never pays the balance.
+	if _, e = periodsLeft(0.01, 5, 1000); e != ErrCalculationError {
+		t.Fatalf("Error, expected: %v got: %v", ErrCalculationError, e)
+	}
+}
+
+// TestEarlyRepaymentFee validate fee breakdown of repaying the remaining loan
+func TestEarlyRepaymentFee(t *testing.T) {
+	s, _ := NewAmortizationSchedule(600000, 0.05, 240, NewDateFromFormattedString("2015-02-01"), Shpitzer)
+	on := s[59].Date
+	remaining := s[60:].CashFlowTab(on)[1:]
+	contract, _ := GetEffectiveRate(0.05, 12)
+	rules := EarlyRepaymentFeeRules{
+		Discount:       0.2,
+		IndexFeeRate:   0.001,
+		OperationalFee: 60,
+	}
+
+	fee, e := NewEarlyRepaymentFee(on, remaining, contract, 0.03, rules)
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	var expected float64
+	for _, c := range remaining {
+		expected += c.Flow / math.Pow(1.03, float64(c.Date.DaysFrom(on))/365)
+	}
+	if round(fee.MarketValue, 6) != round(expected, 6) {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", fee.MarketValue, expected)
+	}
+	// Discounting at the contract rate is (almost) the balance.
+	if math.Abs(fee.Balance/s[59].ClosingBalance-1) > 0.001 {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", fee.Balance, s[59].ClosingBalance)
+	}
+	if fee.DiscountingDifferential <= 0 || round(fee.Discount, 6) != round(fee.DiscountingDifferential*0.2, 6) {
+		t.Fatalf("Error, bad discounting differential: %v", fee)
+	}
+	expected = fee.DiscountingDifferential*0.8 + fee.Balance*0.001 + 60
+	if round(fee.Total, 6) != round(expected, 6) {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", fee.Total, expected)
+	}
+
+	// No discounting differential when market rate is higher.
+	fee, _ = NewEarlyRepaymentFee(on, remaining, contract, 0.07, rules)
+	if fee.DiscountingDifferential != 0 || round(fee.Total, 6) != round(fee.Balance*0.001+60, 6) {
+		t.Fatalf("Error, unexpected fee: %v", fee)
+	}
+
+	if _, e = NewEarlyRepaymentFee(on, nil, contract, 0.07, rules); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestMortgage validate combination of tracks
+func TestMortgage(t *testing.T) {
+	loanDate := NewDateFromFormattedString("2022-03-15")
+	first := loanDate.AddMonth()
+	index := IndexLinkage{Base: 100, BaseDate: loanDate, Inflation: 0.025}
+	prime := RateResetTab{{NewDateFromFormattedString("2022-06-01"), 0.0475}, {NewDateFromFormattedString("2023-01-01"), 0.06}}
+	fiveYears := RateResetTab{{NewDateFromFormattedString("2027-04-15"), 0.04}}
+
+	m := Mortgage{
+		Date: loanDate,
+		Tracks: []MortgageTrack{
+			{"Fixed non linked", Loan{Principal: 400000, Rate: 0.045, Periods: 300, FirstPayment: first, Method: Shpitzer}},
+			{"Prime", Loan{Principal: 300000, Rate: 0.03, Periods: 240, FirstPayment: first, Method: Shpitzer, Resets: prime, Margin: -0.005}},
+			{"CPI linked variable", Loan{Principal: 200000, Rate: 0.025, Periods: 240, FirstPayment: first, Method: SameFromPrincipal, Index: index, Resets: fiveYears}},
+			{"CPI linked fixed", Loan{Principal: 100000, Rate: 0.02, Periods: 180, FirstPayment: first, Method: Shpitzer, Index: index}},
+		},
+	}
+
+	schedules, e := m.Schedules()
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	cf, e := m.CashFlowTab()
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	if len(cf) != 301 || cf.FirstFlow() != -1000000 || !cf.FirstDate().Date.Equal(loanDate.Date) {
+		t.Fatalf("Error, bad merged CashFlowTab: %v rows, first: %v", len(cf), cf[0])
+	}
+
+	summary, e := m.Summary()
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	var payment, payments, interest float64
+	for _, s := range schedules {
+		payment += s[0].Flow
+		payments += s.TotalPayments()
+		interest += s.TotalInterest()
+	}
+	var flows float64
+	for _, c := range cf {
+		flows += c.Flow
+	}
+	if round(summary.MonthlyPayment, 6) != round(payment, 6) || round(summary.TotalPayments, 6) != round(payments, 6) ||
+		round(summary.TotalInterest, 6) != round(interest, 6) || round(flows, 6) != round(payments-1000000, 6) {
+		t.Fatalf("Error, bad summary: %+v", summary)
+	}
+	if summary.Principal != 1000000 || summary.Xirr < 0.03 || summary.Xirr > 0.07 {
+		t.Fatalf("Error, bad summary: %+v", summary)
+	}
+
+	// Tracks with different first payment dates
+	two := Mortgage{
+		Date: NewDateFromFormattedString("2022-01-01"),
+		Tracks: []MortgageTrack{
+			{"First", Loan{Principal: 1000, Rate: 0.05, Periods: 12, FirstPayment: NewDateFromFormattedString("2022-02-01"), Method: Shpitzer}},
+			{"Second", Loan{Principal: 1000, Rate: 0.05, Periods: 12, FirstPayment: NewDateFromFormattedString("2022-02-10"), Method: Shpitzer}},
+		},
+	}
+	summary, e = two.Summary()
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	expected := 2 * Pmt(0.05/12, 12, -1000, 0, false)
+	if round(summary.MonthlyPayment, 6) != round(expected, 6) {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", summary.MonthlyPayment, expected)
+	}
+
+	m.Tracks[0].Loan.Method = 0
+	if _, e = m.Summary(); e != ErrUnknownCalculationType {
+		t.Fatalf("Error, expected: %v got: %v", ErrUnknownCalculationType, e)
+	}
+}
+
+// TestRefinance validate refinancing report
+func TestRefinance(t *testing.T) {
+	s, _ := NewAmortizationSchedule(500000, 0.06, 300, NewDateFromFormattedString("2015-07-01"), Shpitzer)
+	on := NewDateFromFormattedString("2020-06-20")
+	current := s.After(on)
+	if len(current) != 240 {
+		t.Fatalf("Error, remaining rows: \"%v\" Expected: 240", len(current))
+	}
+	offer := RefinanceOffer{Rate: 0.04, Periods: 240, Method: Shpitzer, Fees: 8000}
+
+	r, e := Refinance(on, current, offer, 0.05)
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	saving := current[0].Flow - Pmt(0.04/12, 240, -current[0].OpeningBalance, 0, false)
+	if len(r.Savings) != 241 || r.Savings.FirstFlow() != -8000 || round(r.Savings[1].Flow, 6) != round(saving, 6) {
+		t.Fatalf("Error, bad savings: %v", r.Savings[:2])
+	}
+	if r.BreakEvenMonth != 17 {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", r.BreakEvenMonth, 17)
+	}
+	expected, _ := Xnpv(0.05, r.Savings)
+	if r.Npv <= 0 || r.Npv != expected {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", r.Npv, expected)
+	}
+	expected = current.TotalInterest() - r.Offer.TotalInterest()
+	if r.InterestDifference <= 0 || round(r.InterestDifference, 6) != round(expected, 6) {
+		t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", r.InterestDifference, expected)
+	}
+	npv, _ := Xnpv(r.Xirr, r.Savings)
+	if r.Xirr <= 0 || round(npv, 4) != 0 {
+		t.Fatalf("Error, Xnpv at Xirr: \"%v\" Expected: 0", npv)
+	}
+
+	// Higher rate never breaks even.
+	offer.Rate = 0.07
+	r, _ = Refinance(on, current, offer, 0.05)
+	if r.BreakEvenMonth != 0 || r.Npv >= 0 || r.InterestDifference >= 0 {
+		t.Fatalf("Error, unexpected report: %v %v %v", r.BreakEvenMonth, r.Npv, r.InterestDifference)
+	}
+
+	// Payments up to the refinance date are not savings.
+	if _, e = Refinance(on, s, offer, 0.05); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestScheduleEndOfMonth validate payment dates are counted from the first payment, without drifting
+func TestScheduleEndOfMonth(t *testing.T) {
+	s, e := NewAmortizationSchedule(10000, 0.05, 5, NewDateFromFormattedString("2021-01-31"), Shpitzer)
+	if e != nil {
+		t.Fatalf("Error: %v", e)
+	}
+	expected := []string{"2021-01-31", "2021-02-28", "2021-03-31", "2021-04-30", "2021-05-31"}
+	for i, c := range s {
+		if c.Date.String() != expected[i] {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\"", c.Date, expected[i])
+		}
+	}
+}
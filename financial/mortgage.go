@@ -0,0 +1,75 @@
+package financial
+
+// MortgageTrack is one loan of a mortgage (e.g. fixed non linked, prime, CPI linked).
+type MortgageTrack struct {
+	Name string
+	Loan Loan
+}
+
+// Mortgage combines several tracks, all given at Date.
+type Mortgage struct {
+	Date   Date
+	Tracks []MortgageTrack
+}
+
+// MortgageSummary holds the combined figures of all the tracks.
+type MortgageSummary struct {
+	Principal      float64
+	MonthlyPayment float64 // Combined first payment of all the tracks
+	TotalPayments  float64
+	TotalInterest  float64
+	Xirr           float64 // Blended rate of all the tracks
+}
+
+// Schedules returns the schedule of every track, in the order of the tracks.
+func (m Mortgage) Schedules() (result []calculatedCashFlowTab, e error) {
+	if len(m.Tracks) == 0 {
+		e = ErrParametersError
+		return
+	}
+	for _, t := range m.Tracks {
+		s, err := t.Loan.Schedule()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	return
+}
+
+// CashFlowTab returns all the tracks merged by date, starting with the mortgage itself (negative) at Date.
+func (m Mortgage) CashFlowTab() (result CashFlowTab, e error) {
+	schedules, e := m.Schedules()
+	if e != nil {
+		return
+	}
+	result = m.merge(schedules)
+	return
+}
+
+// merge converts the schedules to CashFlowTab given at Date, and merge them.
+func (m Mortgage) merge(schedules []calculatedCashFlowTab) CashFlowTab {
+	var cftm cashFlowTabMulti
+	for _, s := range schedules {
+		cftm = append(cftm, s.CashFlowTab(m.Date))
+	}
+	return cftm.Merge()
+}
+
+// Summary calculates the combined payment, interest and blended XIRR of the mortgage.
+func (m Mortgage) Summary() (result MortgageSummary, e error) {
+	schedules, e := m.Schedules()
+	if e != nil {
+		return
+	}
+	cf := m.merge(schedules)
+
+	for _, s := range schedules {
+		result.Principal += s[0].OpeningBalance
+		result.MonthlyPayment += s[0].Flow // Tracks may have different first payment dates
+		result.TotalPayments += s.TotalPayments()
+		result.TotalInterest += s.TotalInterest()
+	}
+	result.Xirr, e = Xirr(cf)
+	return
+}
@@ -0,0 +1,115 @@
+package financial
+
+import (
+	"math"
+)
+
+// Odd period functions follow the Excel formulas, the open office analysis add-in does not calculate ODDFPRICE and ODDFYIELD.
+// Odd periods are counted by their quasi coupon periods, so long odd periods are supported.
+
+// getOddfprice is ODDFPRICE calculation, parameters are valid.
+func getOddfprice(nSettle, nMat, nIssue, nFirstCoup Date, fRate, fYield, fRedemp float64, nFreq, nBase int64) float64 {
+	fFreq := float64(nFreq)
+	aSettle := newScaDate(nSettle, nBase)
+	aMat := newScaDate(nMat, nBase)
+	aIssue := newScaDate(nIssue, nBase)
+	aFirst := newScaDate(nFirstCoup, nBase)
+
+	// Settlement to the next quasi coupon date, then whole quasi coupon periods to the first coupon
+	aNcd := getCoupncd(aSettle, aFirst, nFreq)
+	fDSC_E := getCouponFraction(aSettle, aNcd, aFirst, nFreq, nBase)
+	fNq := 0.0
+	for aDate := aNcd; aDate.less(aFirst); aDate.addMonths(12 / int(nFreq)) {
+		fNq++
+	}
+
+	fDFC := getCouponFraction(aIssue, aFirst, aFirst, nFreq, nBase) // First coupon, in regular coupons
+	fA := getCouponFraction(aIssue, aSettle, aFirst, nFreq, nBase)
+	nMonths := (aMat.year-aFirst.year)*12 + aMat.month - aFirst.month
+	fN := float64(int64(nMonths) * nFreq / 12) // Coupons after the first coupon
+
+	fT1 := 100 * fRate / fFreq
+	fT2 := 1 + fYield/fFreq
+
+	fRet := fRedemp / math.Pow(fT2, fN+fNq+fDSC_E)
+	fRet += fT1 * fDFC / math.Pow(fT2, fNq+fDSC_E)
+	for fK := 1.0; fK <= fN; fK++ {
+		fRet += fT1 / math.Pow(fT2, fK+fNq+fDSC_E)
+	}
+	fRet -= fT1 * fA
+	return fRet
+}
+
+// validOddf returns true for valid odd first period parameters.
+func validOddf(settlement, maturity, issue, firstCoupon Date, rate, redemption float64, frequency, basis int64) bool {
+	return rate >= 0 && redemption > 0 && validFrequency(frequency) && validBasis(basis) &&
+		issue.Date.Before(settlement.Date) && settlement.Date.Before(firstCoupon.Date) && firstCoupon.Date.Before(maturity.Date)
+}
+
+// Oddfprice returns the price per 100 face value of a security with an odd (short or long) first period, like Excel ODDFPRICE.
+// Frequency: 1=yearly, 2=half yearly, 4=quarterly.
+// Basis: 0=USA (NASD) 30/360, 1=exact/exact, 2=exact/360, 3=exact/365, 4=Europe 30/360
+func Oddfprice(settlement Date, maturity Date, issue Date, firstCoupon Date, rate float64, yld float64, redemption float64, frequency int64, basis int64) (float64, error) {
+	if yld < 0 || !validOddf(settlement, maturity, issue, firstCoupon, rate, redemption, frequency, basis) {
+		return 0, ErrParametersError
+	}
+	return getOddfprice(settlement, maturity, issue, firstCoupon, rate, yld, redemption, frequency, basis), nil
+}
+
+// Oddfyield returns the yield of a security with an odd (short or long) first period, like Excel ODDFYIELD.
+// Solved iteratively, like Yield.
+func Oddfyield(settlement Date, maturity Date, issue Date, firstCoupon Date, rate float64, pr float64, redemption float64, frequency int64, basis int64) (float64, error) {
+	if pr <= 0 || !validOddf(settlement, maturity, issue, firstCoupon, rate, redemption, frequency, basis) {
+		return 0, ErrParametersError
+	}
+	return solveYield(func(fYield float64) float64 {
+		return getOddfprice(settlement, maturity, issue, firstCoupon, rate, fYield, redemption, frequency, basis)
+	}, pr)
+}
+
+// getOddlFractions returns the odd last period (DC), accrued (A) and remaining (DSC) parts, in regular coupons.
+func getOddlFractions(nSettle, nMat, nLastCoup Date, nFreq, nBase int64) (fDC, fA, fDSC float64) {
+	aSettle := newScaDate(nSettle, nBase)
+	aMat := newScaDate(nMat, nBase)
+	aLast := newScaDate(nLastCoup, nBase)
+
+	fDC = getCouponFraction(aLast, aMat, aLast, nFreq, nBase)
+	fA = getCouponFraction(aLast, aSettle, aLast, nFreq, nBase)
+	fDSC = getCouponFraction(aSettle, aMat, aLast, nFreq, nBase)
+	return
+}
+
+// validOddl returns true for valid odd last period parameters.
+func validOddl(settlement, maturity, lastInterest Date, rate, redemption float64, frequency, basis int64) bool {
+	return rate >= 0 && redemption > 0 && validFrequency(frequency) && validBasis(basis) &&
+		lastInterest.Date.Before(settlement.Date) && settlement.Date.Before(maturity.Date)
+}
+
+// Oddlprice returns the price per 100 face value of a security with an odd (short or long) last period, like Excel ODDLPRICE.
+func Oddlprice(settlement Date, maturity Date, lastInterest Date, rate float64, yld float64, redemption float64, frequency int64, basis int64) (float64, error) {
+	if yld < 0 || !validOddl(settlement, maturity, lastInterest, rate, redemption, frequency, basis) {
+		return 0, ErrParametersError
+	}
+	fFreq := float64(frequency)
+	fDC, fA, fDSC := getOddlFractions(settlement, maturity, lastInterest, frequency, basis)
+
+	p := redemption + fDC*100*rate/fFreq
+	p /= fDSC*yld/fFreq + 1
+	p -= fA * 100 * rate / fFreq
+	return p, nil
+}
+
+// Oddlyield returns the yield of a security with an odd (short or long) last period, like Excel ODDLYIELD.
+func Oddlyield(settlement Date, maturity Date, lastInterest Date, rate float64, pr float64, redemption float64, frequency int64, basis int64) (float64, error) {
+	if pr <= 0 || !validOddl(settlement, maturity, lastInterest, rate, redemption, frequency, basis) {
+		return 0, ErrParametersError
+	}
+	fFreq := float64(frequency)
+	fDC, fA, fDSC := getOddlFractions(settlement, maturity, lastInterest, frequency, basis)
+
+	y := redemption + fDC*100*rate/fFreq
+	y /= pr + fA*100*rate/fFreq
+	y--
+	y *= fFreq / fDSC
+	return y, nil
+}
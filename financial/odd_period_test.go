@@ -0,0 +1,130 @@
+package financial
+
+import (
+	"github.com/aviplot/go-finance-math/test/testdata"
+	"testing"
+)
+
+// TestOddfpriceOddfyield validate ODDFPRICE and ODDFYIELD functions
+func TestOddfpriceOddfyield(t *testing.T) {
+	for _, td := range testdata.TESTGetOddfpriceTestData() {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Oddfprice(NewDateFromFormattedString(td.Settlement), NewDateFromFormattedString(td.Maturity), NewDateFromFormattedString(td.Issue),
+			NewDateFromFormattedString(td.Coupon), td.Rate, td.Value, td.Redemption, td.Frequency, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	for _, td := range testdata.TESTGetOddfyieldTestData() {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Oddfyield(NewDateFromFormattedString(td.Settlement), NewDateFromFormattedString(td.Maturity), NewDateFromFormattedString(td.Issue),
+			NewDateFromFormattedString(td.Coupon), td.Rate, td.Value, td.Redemption, td.Frequency, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	// Long first period, the yield of the price is also the original yield
+	settlement := NewDateFromFormattedString("2008-02-20")
+	maturity := NewDateFromFormattedString("2012-03-01")
+	issue := NewDateFromFormattedString("2008-01-15")
+	firstCoupon := NewDateFromFormattedString("2009-03-01")
+	for basis := int64(0); basis <= 4; basis++ {
+		price, e := Oddfprice(settlement, maturity, issue, firstCoupon, 0.06, 0.07, 100, 2, basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result, e := Oddfyield(settlement, maturity, issue, firstCoupon, 0.06, price, 100, 2, basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		if round(result, 9) != 0.07 {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Basis: %v)", result, 0.07, basis)
+		}
+	}
+
+	// Settlement must be between the issue and the first coupon
+	settlement = NewDateFromFormattedString("2008-11-11")
+	maturity = NewDateFromFormattedString("2021-03-01")
+	issue = NewDateFromFormattedString("2008-10-15")
+	firstCoupon = NewDateFromFormattedString("2009-03-01")
+	if _, e := Oddfprice(issue, maturity, settlement, firstCoupon, 0.0785, 0.0625, 100, 2, 1); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Oddfyield(settlement, maturity, issue, firstCoupon, 0.0575, 0, 100, 2, 0); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
+
+// TestOddlpriceOddlyield validate ODDLPRICE and ODDLYIELD functions
+func TestOddlpriceOddlyield(t *testing.T) {
+	for _, td := range testdata.TESTGetOddlpriceTestData() {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Oddlprice(NewDateFromFormattedString(td.Settlement), NewDateFromFormattedString(td.Maturity), NewDateFromFormattedString(td.Coupon),
+			td.Rate, td.Value, td.Redemption, td.Frequency, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	for _, td := range testdata.TESTGetOddlyieldTestData() {
+		expected := td.Result
+		precision := getPrecisionFromFloat(expected)
+
+		result, e := Oddlyield(NewDateFromFormattedString(td.Settlement), NewDateFromFormattedString(td.Maturity), NewDateFromFormattedString(td.Coupon),
+			td.Rate, td.Value, td.Redemption, td.Frequency, td.Basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result = round(result, precision)
+		if result != expected {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Precision: %v)", result, expected, precision)
+		}
+	}
+
+	// Long last period, the yield of the price is the original yield
+	settlement := NewDateFromFormattedString("2008-02-07")
+	maturity := NewDateFromFormattedString("2008-06-15")
+	lastInterest := NewDateFromFormattedString("2007-06-15")
+	for basis := int64(0); basis <= 4; basis++ {
+		price, e := Oddlprice(settlement, maturity, lastInterest, 0.0375, 0.0405, 100, 2, basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		result, e := Oddlyield(settlement, maturity, lastInterest, 0.0375, price, 100, 2, basis)
+		if e != nil {
+			t.Fatalf("Error: %v", e)
+		}
+		if round(result, 9) != 0.0405 {
+			t.Fatalf("Error, result: \"%v\" Expected: \"%v\" (Basis: %v)", result, 0.0405, basis)
+		}
+	}
+
+	// Settlement must be after the last interest
+	lastInterest = NewDateFromFormattedString("2007-10-15")
+	if _, e := Oddlprice(lastInterest, maturity, settlement, 0.0375, 0.0405, 100, 2, 0); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+	if _, e := Oddlyield(settlement, maturity, lastInterest, 0.0375, 99.875, 100, 3, 0); e != ErrParametersError {
+		t.Fatalf("Error, expected: %v got: %v", ErrParametersError, e)
+	}
+}
@@ -0,0 +1,86 @@
+package financial
+
+import (
+	"math"
+)
+
+type PrepaymentMode int
+
+const (
+	ReducePayment PrepaymentMode = iota + 1 // Keep the term, the payment is recalculated
+	ReduceTerm                              // Keep the payment, the loan ends earlier (payment is reduced for balloon loans and in the grace period)
+)
+
+// Prepayment is a partial repayment of the loan, on top of the regular payment.
+type Prepayment struct {
+	Date   Date    `json:"date"`
+	Amount float64 `json:"amount"`
+}
+
+// PrepaymentTab is just slice of Prepayment.
+type PrepaymentTab []Prepayment
+
+// PrepaymentResult compares the loan schedule with and without the prepayments.
+type PrepaymentResult struct {
+	Original      calculatedCashFlowTab
+	Revised       calculatedCashFlowTab
+	InterestSaved float64
+	PeriodsSaved  int
+}
+
+// Prepay calculates the loan schedule with the prepayments, and compares it to the schedule without them.
+// Prepayments must not be after the last scheduled payment.
+// ReduceTerm keeps the payment and ends the loan earlier, except for balloon loans and prepayments during the grace
+// period, where the payment is recalculated like ReducePayment.
+func (l Loan) Prepay(p PrepaymentTab, mode PrepaymentMode) (result PrepaymentResult, e error) {
+	original := l
+	original.Prepayments = nil
+	result.Original, e = original.Schedule()
+	if e != nil {
+		return
+	}
+
+	l.Prepayments = p
+	l.PrepaymentMode = mode
+	result.Revised, e = l.Schedule()
+	if e != nil {
+		return
+	}
+
+	result.InterestSaved = result.Original.TotalInterest() - result.Revised.TotalInterest()
+	result.PeriodsSaved = len(result.Original) - len(result.Revised)
+	return
+}
+
+// amountBetween sums the prepayments after from, up to (including) to.
+func (pt PrepaymentTab) amountBetween(from, to Date) (result float64) {
+	for _, p := range pt {
+		if p.Date.Date.After(from.Date) && !p.Date.Date.After(to.Date) {
+			result += p.Amount
+		}
+	}
+	return
+}
+
+// valid returns false for negative amounts, dates after the last payment, or unknown mode.
+func (pt PrepaymentTab) valid(mode PrepaymentMode, lastPayment Date) bool {
+	if len(pt) == 0 {
+		return true
+	}
+	for _, p := range pt {
+		if p.Amount < 0 || p.Date.Date.After(lastPayment.Date) {
+			return false
+		}
+	}
+	return mode == ReducePayment || mode == ReduceTerm
+}
+
+// periodsLeft returns the amount of payments needed to pay the balance, rounded up.
+func periodsLeft(rate float64, pmt float64, balance float64) (int64, error) {
+	n, e := Nper(rate, -pmt, balance, 0, false)
+	if e != nil {
+		return 0, e
+	}
+	// Neglecting floating point leftovers.
+	return int64(math.Ceil(round(n, 9))), nil
+}
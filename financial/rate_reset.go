@@ -0,0 +1,53 @@
+package financial
+
+import (
+	"sort"
+)
+
+// RateReset sets the annual rate of a loan, from Date on.
+type RateReset struct {
+	Date Date    `json:"date"`
+	Rate float64 `json:"rate"`
+}
+
+// RateResetTab is just slice of RateReset, can be a table of new rates, or a series of base rate (like prime).
+type RateResetTab []RateReset
+
+// RateAt returns the rate of the last reset up to d, and false if there is no such reset.
+func (rt RateResetTab) RateAt(d Date) (rate float64, found bool) {
+	return rt.OrderByDate().rateAt(d)
+}
+
+// rateAt is RateAt, the tab is expected to be ordered by date.
+func (rt RateResetTab) rateAt(d Date) (rate float64, found bool) {
+	for _, r := range rt {
+		if r.Date.Date.After(d.Date) {
+			break
+		}
+		rate = r.Rate
+		found = true
+	}
+	return
+}
+
+// Len impl "Interface" to support sorting, using sort.Sort.
+func (rt RateResetTab) Len() int {
+	return len(rt)
+}
+
+// Swap impl "Interface" to support sorting, using sort.Sort.
+func (rt RateResetTab) Swap(i, j int) {
+	rt[i], rt[j] = rt[j], rt[i]
+}
+
+// Less impl "Interface" to support sorting, using sort.Sort.
+func (rt RateResetTab) Less(i, j int) bool {
+	return rt[i].Date.Date.Before(rt[j].Date.Date)
+}
+
+// OrderByDate returns sorted copy of the tab.
+func (rt RateResetTab) OrderByDate() (r RateResetTab) {
+	r = append(r, rt...)
+	sort.Sort(r)
+	return
+}
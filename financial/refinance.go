@@ -0,0 +1,70 @@
+package financial
+
+// RefinanceOffer is a new loan, offered to repay the balance of an existing loan.
+type RefinanceOffer struct {
+	Rate    float64 // Annual rate
+	Periods int64   // Number of monthly payments
+	Method  CalculationType
+	Fees    float64 // Paid when refinancing
+}
+
+// RefinanceReport compares the remaining schedule of the existing loan with the offer.
+type RefinanceReport struct {
+	Current            calculatedCashFlowTab // Remaining schedule of the existing loan
+	Offer              calculatedCashFlowTab // Schedule of the new loan
+	Savings            CashFlowTab           // Incremental flows of the borrower: fees, then current payments less offer payments
+	Npv                float64               // Savings discounted at the discount rate
+	BreakEvenMonth     int                   // First month the accumulated savings cover the fees, zero if never
+	InterestDifference float64               // Interest saved, current interest less offer interest
+	Xirr               float64               // XIRR of the savings, zero if can't be calculated
+}
+
+// Refinance compares repaying the remaining schedule (current) at date on, using the offer.
+// The offer payments start at the date of the first remaining payment.
+// current must start after on, discountRate is annual, savings are discounted like Xnpv.
+func Refinance(on Date, current calculatedCashFlowTab, offer RefinanceOffer, discountRate float64) (r RefinanceReport, e error) {
+	if len(current) == 0 || !current[0].Date.Date.After(on.Date) || offer.Fees < 0 || discountRate <= -1 {
+		e = ErrParametersError
+		return
+	}
+
+	l := Loan{
+		Principal:    current[0].OpeningBalance,
+		Rate:         offer.Rate,
+		Periods:      offer.Periods,
+		FirstPayment: current[0].Date,
+		Method:       offer.Method,
+	}
+	r.Offer, e = l.Schedule()
+	if e != nil {
+		return
+	}
+	r.Current = current
+
+	savings := cashFlowTabMulti{CashFlowTab{CashFlow{on, -offer.Fees}}, nil, nil}
+	for _, c := range current {
+		savings[1] = append(savings[1], c.CashFlow)
+	}
+	for _, c := range r.Offer {
+		savings[2] = append(savings[2], CashFlow{c.Date, -c.Flow})
+	}
+	r.Savings = savings.Merge()
+
+	r.Npv, e = Xnpv(discountRate, r.Savings)
+	if e != nil {
+		return
+	}
+	var accumulated float64
+	for i, c := range r.Savings {
+		accumulated += c.Flow
+		if i > 0 && accumulated >= 0 {
+			r.BreakEvenMonth = i
+			break
+		}
+	}
+	r.InterestDifference = current.TotalInterest() - r.Offer.TotalInterest()
+	if x, err := Xirr(r.Savings); err == nil {
+		r.Xirr = x
+	}
+	return
+}
@@ -0,0 +1,194 @@
+package financial
+
+import (
+	"time"
+)
+
+// scaDate is a date in a day count basis (ScaDate of the open office analysis add-in).
+// Adding months keeps the last day of the month, and 30/360 bases use 30 days months.
+type scaDate struct {
+	origDay     int
+	day         int
+	month       int
+	year        int
+	lastDayMode bool // Recalculate day after every calculation
+	lastDay     bool // Original day is the last day of the month
+	days30      bool // Basis 30/360 (US or Europe)
+	usMode      bool // US method of 30/360
+}
+
+// newScaDate returns scaDate of d in basis nBase.
+func newScaDate(d Date, nBase int64) (s scaDate) {
+	nYear, nMonth, nDay := d.Date.Date()
+	s.origDay = nDay
+	s.month = int(nMonth)
+	s.year = nYear
+	s.lastDayMode = nBase != 5
+	s.lastDay = d.IsEndOfMonth()
+	s.days30 = nBase == 0 || nBase == 4
+	s.usMode = nBase == 0
+	s.setDay()
+	return
+}
+
+// setDay calculates day from the original day, in the current month.
+func (s *scaDate) setDay() {
+	if s.days30 {
+		// 30 days mode, day is 30 if the original was the last day in month
+		s.day = s.origDay
+		if s.day > 30 {
+			s.day = 30
+		}
+		if s.lastDay || s.day >= daysInMonth(s.month, s.year) {
+			s.day = 30
+		}
+	} else {
+		// Last day in this month, if the original was the last day
+		nLastDay := daysInMonth(s.month, s.year)
+		if s.lastDay || s.origDay > nLastDay {
+			s.day = nLastDay
+		} else {
+			s.day = s.origDay
+		}
+	}
+}
+
+func (s scaDate) getDaysInMonth(nMonth int) int {
+	if s.days30 {
+		return 30
+	}
+	return daysInMonth(nMonth, s.year)
+}
+
+func (s scaDate) getDaysInMonthRange(nFrom, nTo int) (nRet int) {
+	if nFrom > nTo {
+		return 0
+	}
+	if s.days30 {
+		return (nTo - nFrom + 1) * 30
+	}
+	for i := nFrom; i <= nTo; i++ {
+		nRet += s.getDaysInMonth(i)
+	}
+	return
+}
+
+func (s scaDate) getDaysInYearRange(nFrom, nTo int) int {
+	if nFrom > nTo {
+		return 0
+	}
+	if s.days30 {
+		return (nTo - nFrom + 1) * 360
+	}
+	return getDaysInYears(nFrom, nTo)
+}
+
+func (s *scaDate) addMonths(nMonthCount int) {
+	nNewMonth := nMonthCount + s.month
+	if nNewMonth > 12 {
+		nNewMonth--
+		s.year += nNewMonth / 12
+		s.month = nNewMonth%12 + 1
+	} else if nNewMonth < 1 {
+		s.year += nNewMonth/12 - 1
+		s.month = nNewMonth%12 + 12
+	} else {
+		s.month = nNewMonth
+	}
+	s.setDay()
+}
+
+func (s *scaDate) addYears(nYearCount int) {
+	s.year += nYearCount
+	s.setDay()
+}
+
+func (s *scaDate) setYear(nYear int) {
+	s.year = nYear
+	s.setDay()
+}
+
+// date returns the real date (the original day, or the last day of the month).
+func (s scaDate) date() Date {
+	nLastDay := daysInMonth(s.month, s.year)
+	nRealDay := s.origDay
+	if (s.lastDayMode && s.lastDay) || nRealDay > nLastDay {
+		nRealDay = nLastDay
+	}
+	return Date{time.Date(s.year, time.Month(s.month), nRealDay, 0, 0, 0, 0, time.UTC)}
+}
+
+func (s scaDate) less(cmp scaDate) bool {
+	if s.year != cmp.year {
+		return s.year < cmp.year
+	}
+	if s.month != cmp.month {
+		return s.month < cmp.month
+	}
+	if s.day != cmp.day {
+		return s.day < cmp.day
+	}
+	if s.lastDay || cmp.lastDay {
+		return !s.lastDay && cmp.lastDay
+	}
+	return s.origDay < cmp.origDay
+}
+
+// scaDateDiff returns the days between the dates, in the basis of the dates.
+func scaDateDiff(rFrom, rTo scaDate) int {
+	if rTo.less(rFrom) {
+		return scaDateDiff(rTo, rFrom)
+	}
+
+	nDiff := 0
+	aFrom := rFrom
+	aTo := rTo
+
+	if rTo.days30 {
+		if rTo.usMode {
+			// Corrections for base 0 (US NASD)
+			if (rFrom.month == 2 || rFrom.day < 30) && aTo.origDay == 31 {
+				aTo.day = 31
+			} else if aTo.month == 2 && aTo.lastDay {
+				aTo.day = daysInMonth(2, aTo.year)
+			}
+		} else {
+			// Corrections for base 4 (Europe)
+			if aFrom.month == 2 && aFrom.day == 30 {
+				aFrom.day = daysInMonth(2, aFrom.year)
+			}
+			if aTo.month == 2 && aTo.day == 30 {
+				aTo.day = daysInMonth(2, aTo.year)
+			}
+		}
+	}
+
+	if aFrom.year < aTo.year || (aFrom.year == aTo.year && aFrom.month < aTo.month) {
+		// Move aFrom to 1st day of next month
+		nDiff = aFrom.getDaysInMonth(aFrom.month) - aFrom.day + 1
+		aFrom.origDay = 1
+		aFrom.day = 1
+		aFrom.lastDay = false
+		aFrom.addMonths(1)
+
+		if aFrom.year < aTo.year {
+			// Move aFrom to 1st day of next year
+			nDiff += aFrom.getDaysInMonthRange(aFrom.month, 12)
+			aFrom.addMonths(13 - aFrom.month)
+
+			// Move aFrom to 1st day of this year
+			nDiff += aFrom.getDaysInYearRange(aFrom.year, aTo.year-1)
+			aFrom.addYears(aTo.year - aFrom.year)
+		}
+
+		// Move aFrom to 1st day of this month
+		nDiff += aFrom.getDaysInMonthRange(aFrom.month, aTo.month-1)
+		aFrom.addMonths(aTo.month - aFrom.month)
+	}
+	// Remaining days in this month
+	nDiff += aTo.day - aFrom.day
+	if nDiff > 0 {
+		return nDiff
+	}
+	return 0
+}
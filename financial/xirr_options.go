@@ -0,0 +1,93 @@
+package financial
+
+import (
+	"math"
+)
+
+// maximum number of bisection iterations
+const nMaxBisectionIter = 1000
+
+// XirrOptions controls the XIRR calculation.
+type XirrOptions struct {
+	Guess         float64 // Starting rate of Newton's method
+	Tolerance     float64 // Maximum epsilon for end of iteration
+	MaxIterations int64   // Maximum Newton's iterations, for every starting rate
+	MaxScans      int64   // Maximum starting rates, the guess and then from -0.99 (or MinRate) in steps of 0.01
+	MinRate       float64 // Allowed rates are above MinRate, -1 (-100%) or more
+	MaxRate       float64 // Allowed rates are up to MaxRate (including)
+}
+
+// DefaultXirrOptions returns the options used by Xirr.
+func DefaultXirrOptions() XirrOptions {
+	return XirrOptions{
+		Guess:         0.1,
+		Tolerance:     1e-10,
+		MaxIterations: 50,
+		MaxScans:      200,
+		MinRate:       -1,
+		MaxRate:       math.Inf(1),
+	}
+}
+
+// XirrWithOptions calculation XIRR like Xirr, using the options.
+// When Newton's method fails, the rate is searched by bisection, within the allowed rates.
+// That helps with very negative, or very high rates.
+func XirrWithOptions(cf CashFlowTab, o XirrOptions) (fResultRate float64, e error) {
+	if o.Tolerance <= 0 || o.MaxIterations <= 0 || o.MaxScans <= 0 || o.MinRate < -1 || o.MaxRate <= o.MinRate ||
+		o.Guess <= -1 {
+		e = ErrParametersError
+		return
+	}
+
+	fResultRate, e = xirrNewton(cf, o)
+	if e != ErrCalculationError {
+		return
+	}
+	return xirrBisection(cf, o)
+}
+
+// xirrBisection searches for a range with sign change of xirrResult, and then bisects it.
+func xirrBisection(cf CashFlowTab, o XirrOptions) (fResultRate float64, e error) {
+	// Candidate bounds, from the lowest rate up, growing exponentially.
+	fLow := o.MinRate
+	if fLow <= -1 {
+		fLow = -1 + o.Tolerance
+	}
+	bounds := []float64{fLow}
+	for _, r := range []float64{-0.999, -0.99, -0.9, -0.5, 0} {
+		if r > fLow && r < o.MaxRate {
+			bounds = append(bounds, r)
+		}
+	}
+	for r := 1.0; r < o.MaxRate && r < 1e12; r *= 2 {
+		bounds = append(bounds, r)
+	}
+	if !math.IsInf(o.MaxRate, 1) {
+		bounds = append(bounds, o.MaxRate)
+	}
+
+	fLowValue := xirrResult(cf, fLow)
+	for _, fHigh := range bounds[1:] {
+		fHighValue := xirrResult(cf, fHigh)
+		if math.Signbit(fLowValue) == math.Signbit(fHighValue) {
+			fLow, fLowValue = fHigh, fHighValue
+			continue
+		}
+		// Bisection
+		for nIter := 0; nIter < nMaxBisectionIter; nIter++ {
+			fResultRate = (fLow + fHigh) / 2
+			fResultValue := xirrResult(cf, fResultRate)
+			if fHigh-fLow <= o.Tolerance || fResultValue == 0 {
+				return
+			}
+			if math.Signbit(fResultValue) == math.Signbit(fLowValue) {
+				fLow, fLowValue = fResultRate, fResultValue
+			} else {
+				fHigh = fResultRate
+			}
+		}
+		return
+	}
+	e = ErrCalculationError
+	return
+}
@@ -0,0 +1,32 @@
+package testdata
+
+type accrintTestData struct {
+	Issue         string // "yyyy-mm-dd"
+	FirstInterest string // "yyyy-mm-dd", Accrint only
+	Settlement    string // "yyyy-mm-dd"
+	Rate          float64
+	Par           float64
+	Frequency     int64 // Accrint only
+	Basis         int64
+	CalcMethod    bool // Accrint only
+	Result        float64
+}
+
+func TESTGetAccrintTestData() []accrintTestData {
+	return []accrintTestData{
+		{Issue: "2008-03-01", FirstInterest: "2008-08-31", Settlement: "2008-05-01", Rate: 0.1, Par: 1000, Frequency: 2, Basis: 0, CalcMethod: true, Result: 16.666667},
+		{Issue: "2008-03-05", FirstInterest: "2008-08-31", Settlement: "2008-05-01", Rate: 0.1, Par: 1000, Frequency: 2, Basis: 0, CalcMethod: false, Result: 15.555556},
+		{Issue: "2020-01-15", FirstInterest: "2020-06-30", Settlement: "2021-02-10", Rate: 0.06, Par: 1000, Frequency: 2, Basis: 1, CalcMethod: true, Result: 64.323053},
+		{Issue: "2020-01-15", FirstInterest: "2020-06-30", Settlement: "2021-02-10", Rate: 0.06, Par: 1000, Frequency: 2, Basis: 1, CalcMethod: false, Result: 6.79558},
+		{Issue: "2020-01-15", FirstInterest: "2020-06-30", Settlement: "2021-02-10", Rate: 0.06, Par: 1000, Frequency: 2, Basis: 0, CalcMethod: true, Result: 64.166667},
+		{Issue: "2020-01-15", FirstInterest: "2020-06-30", Settlement: "2021-02-10", Rate: 0.06, Par: 1000, Frequency: 2, Basis: 0, CalcMethod: false, Result: 6.666667},
+	}
+}
+
+func TESTGetAccrintmTestData() []accrintTestData {
+	return []accrintTestData{
+		{Issue: "2008-04-01", Settlement: "2008-06-15", Rate: 0.1, Par: 1000, Basis: 3, Result: 20.54795},
+		{Issue: "2008-04-01", Settlement: "2008-06-15", Rate: 0.1, Par: 1000, Basis: 0, Result: 20.555556},
+		{Issue: "2008-04-01", Settlement: "2008-06-15", Rate: 0.1, Par: 1000, Basis: 2, Result: 20.833333},
+	}
+}
@@ -0,0 +1,54 @@
+package testdata
+
+type amorTestData struct {
+	Cost        float64
+	Purchased   string // "yyyy-mm-dd"
+	FirstPeriod string // "yyyy-mm-dd"
+	Salvage     float64
+	Period      float64
+	Rate        float64
+	Basis       int64
+	Amordegrc   float64
+	Amorlinc    float64
+}
+
+type yearFracTestData struct {
+	Start  string // "yyyy-mm-dd"
+	End    string // "yyyy-mm-dd"
+	Basis  int64
+	Result float64
+}
+
+func TESTGetAmorTestData() []amorTestData {
+	return []amorTestData{
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 0, Rate: 0.15, Basis: 1, Amordegrc: 330, Amorlinc: 131.803279},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 1, Rate: 0.15, Basis: 1, Amordegrc: 776, Amorlinc: 360},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 2, Rate: 0.15, Basis: 1, Amordegrc: 485, Amorlinc: 360},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 3, Rate: 0.15, Basis: 1, Amordegrc: 303, Amorlinc: 360},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 4, Rate: 0.15, Basis: 1, Amordegrc: 190, Amorlinc: 360},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 5, Rate: 0.15, Basis: 1, Amordegrc: 158, Amorlinc: 360},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 6, Rate: 0.15, Basis: 1, Amordegrc: 0, Amorlinc: 168.196721},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 7, Rate: 0.15, Basis: 1, Amordegrc: 0, Amorlinc: 0},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 0, Rate: 0.15, Basis: 0, Amordegrc: 330, Amorlinc: 132},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 6, Rate: 0.15, Basis: 0, Amordegrc: 0, Amorlinc: 168},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 0, Rate: 0.15, Basis: 3, Amordegrc: 330, Amorlinc: 132.164384},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 6, Rate: 0.15, Basis: 3, Amordegrc: 0, Amorlinc: 167.835616},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 0, Rate: 0.15, Basis: 4, Amordegrc: 327, Amorlinc: 131},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 1, Rate: 0.15, Basis: 4, Amordegrc: 777, Amorlinc: 360},
+		{Cost: 2400, Purchased: "2008-08-19", FirstPeriod: "2008-12-31", Salvage: 300, Period: 6, Rate: 0.15, Basis: 4, Amordegrc: 0, Amorlinc: 169},
+	}
+}
+
+func TESTGetYearFracTestData() []yearFracTestData {
+	return []yearFracTestData{
+		{Start: "2012-01-01", End: "2012-07-30", Basis: 0, Result: 0.58055556},
+		{Start: "2012-01-01", End: "2012-07-30", Basis: 1, Result: 0.57650273},
+		{Start: "2012-01-01", End: "2012-07-30", Basis: 3, Result: 0.57808219},
+		{Start: "2011-02-28", End: "2012-02-29", Basis: 0, Result: 1},
+		{Start: "2011-02-28", End: "2012-02-29", Basis: 1, Result: 1.00136799},
+		{Start: "2010-03-31", End: "2015-04-30", Basis: 1, Result: 5.08261068},
+		{Start: "2010-03-31", End: "2015-04-30", Basis: 2, Result: 5.15555556},
+		{Start: "2010-03-31", End: "2015-04-30", Basis: 4, Result: 5.08333333},
+		{Start: "2015-04-30", End: "2010-03-31", Basis: 4, Result: 5.08333333},
+	}
+}
@@ -0,0 +1,78 @@
+package testdata
+
+type bondTestData struct {
+	Settlement string // "yyyy-mm-dd"
+	Maturity   string // "yyyy-mm-dd"
+	Rate       float64
+	Yield      float64
+	Price      float64 // Per 100 face value
+	Redemption float64
+	Frequency  int64
+	Basis      int64
+}
+
+func TESTGetBondTestData() []bondTestData {
+	return []bondTestData{
+		{
+			Settlement: "2008-02-15",
+			Maturity:   "2017-11-15",
+			Rate:       0.0575,
+			Yield:      0.065,
+			Price:      94.63436,
+			Redemption: 100,
+			Frequency:  2,
+			Basis:      0,
+		},
+		{
+			Settlement: "2008-02-15",
+			Maturity:   "2016-11-15",
+			Rate:       0.0575,
+			Yield:      0.065,
+			Price:      95.04287,
+			Redemption: 100,
+			Frequency:  2,
+			Basis:      0,
+		},
+		{
+			Settlement: "2008-02-15",
+			Maturity:   "2017-11-15",
+			Rate:       0.0575,
+			Yield:      0.065,
+			Price:      94.635449,
+			Redemption: 100,
+			Frequency:  2,
+			Basis:      1,
+		},
+		{
+			Settlement: "2010-03-10",
+			Maturity:   "2015-12-31",
+			Rate:       0.04,
+			Yield:      0.05,
+			Price:      98.745828,
+			Redemption: 105,
+			Frequency:  4,
+			Basis:      3,
+		},
+		{
+			// One coupon until the redemption
+			Settlement: "2008-06-01",
+			Maturity:   "2008-11-15",
+			Rate:       0.0575,
+			Yield:      0.065,
+			Price:      99.66081,
+			Redemption: 100,
+			Frequency:  2,
+			Basis:      0,
+		},
+		{
+			Settlement: "2008-06-01",
+			Maturity:   "2008-11-15",
+			Rate:       0.0575,
+			Yield:      0.065,
+			Price:      99.661788,
+			Redemption: 100,
+			Frequency:  2,
+			Basis:      1,
+		},
+	}
+}
@@ -63,5 +63,20 @@ func TESTGetCashflowTestData() []CashFlowTestData {
 			Pv:              0,
 			Coefficient:     -16.029548931,
 		},
+		{
+			Rate:            0.05, // 5%
+			Amount:          -100000,
+			DateStart:       "2000-05-15",
+			Income:          2000,
+			DateIncomeStart: "2000-06-15",
+			IncomeTimes:     60,
+			Balloon:         30000,
+			BalloonDate:     "2005-05-15", // With the last income
+			ExpectedXIRR:    0.15309796,
+			ExpectedIRR:     0.0119548464,
+			ExpectedNPV:     29754.649,
+			Pv:              0,
+			Coefficient:     -52.990706324,
+		},
 	}
 }
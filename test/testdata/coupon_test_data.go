@@ -0,0 +1,31 @@
+package testdata
+
+type couponTestData struct {
+	Settlement string // "yyyy-mm-dd"
+	Maturity   string // "yyyy-mm-dd"
+	Frequency  int64
+	Basis      int64
+	DayBs      float64
+	Days       float64
+	DaysNc     float64
+	Ncd        string // "yyyy-mm-dd"
+	Num        float64
+	Pcd        string // "yyyy-mm-dd"
+}
+
+func TESTGetCouponTestData() []couponTestData {
+	return []couponTestData{
+		{Settlement: "2011-01-25", Maturity: "2011-11-15", Frequency: 2, Basis: 1, DayBs: 71, Days: 181, DaysNc: 110, Ncd: "2011-05-15", Num: 2, Pcd: "2010-11-15"},
+		{Settlement: "2011-01-25", Maturity: "2011-11-15", Frequency: 2, Basis: 0, DayBs: 70, Days: 180, DaysNc: 110, Ncd: "2011-05-15", Num: 2, Pcd: "2010-11-15"},
+		{Settlement: "2007-01-25", Maturity: "2008-11-15", Frequency: 2, Basis: 1, DayBs: 71, Days: 181, DaysNc: 110, Ncd: "2007-05-15", Num: 4, Pcd: "2006-11-15"},
+		{Settlement: "2011-01-25", Maturity: "2011-11-15", Frequency: 1, Basis: 3, DayBs: 71, Days: 365, DaysNc: 294, Ncd: "2011-11-15", Num: 1, Pcd: "2010-11-15"},
+		// End of month maturity, coupons are on the end of month
+		{Settlement: "2022-09-15", Maturity: "2023-02-28", Frequency: 4, Basis: 1, DayBs: 15, Days: 91, DaysNc: 76, Ncd: "2022-11-30", Num: 2, Pcd: "2022-08-31"},
+		{Settlement: "2022-09-15", Maturity: "2023-02-28", Frequency: 4, Basis: 0, DayBs: 15, Days: 90, DaysNc: 75, Ncd: "2022-11-30", Num: 2, Pcd: "2022-08-31"},
+		{Settlement: "2023-10-01", Maturity: "2024-02-29", Frequency: 2, Basis: 1, DayBs: 31, Days: 182, DaysNc: 151, Ncd: "2024-02-29", Num: 1, Pcd: "2023-08-31"},
+		{Settlement: "2023-10-01", Maturity: "2024-02-29", Frequency: 2, Basis: 2, DayBs: 31, Days: 180, DaysNc: 151, Ncd: "2024-02-29", Num: 1, Pcd: "2023-08-31"},
+		{Settlement: "2023-10-01", Maturity: "2024-02-29", Frequency: 2, Basis: 4, DayBs: 31, Days: 180, DaysNc: 149, Ncd: "2024-02-29", Num: 1, Pcd: "2023-08-31"},
+		// Settlement on a coupon date
+		{Settlement: "2022-08-31", Maturity: "2023-02-28", Frequency: 4, Basis: 1, DayBs: 0, Days: 91, DaysNc: 91, Ncd: "2022-11-30", Num: 2, Pcd: "2022-08-31"},
+	}
+}
@@ -0,0 +1,67 @@
+package testdata
+
+type cumulativeTestData struct {
+	Rate     float64
+	Nper     int64
+	Pv       float64
+	Start    int64
+	End      int64
+	Type     bool
+	CumIpmt  float64
+	CumPrinc float64
+}
+
+func TESTGetCumulativeTestData() []cumulativeTestData {
+	return []cumulativeTestData{
+		{
+			Rate:     0.09 / 12,
+			Nper:     360,
+			Pv:       125000,
+			Start:    13,
+			End:      24,
+			Type:     false,
+			CumIpmt:  -11135.23213,
+			CumPrinc: -934.1071234,
+		},
+		{
+			Rate:     0.09 / 12,
+			Nper:     360,
+			Pv:       125000,
+			Start:    1,
+			End:      1,
+			Type:     false,
+			CumIpmt:  -937.5,
+			CumPrinc: -68.27827118,
+		},
+		{
+			Rate:     0.09 / 12,
+			Nper:     360,
+			Pv:       125000,
+			Start:    349,
+			End:      360,
+			Type:     false,
+			CumIpmt:  -568.3525547,
+			CumPrinc: -11500.9866995,
+		},
+		{
+			Rate:     0.09 / 12,
+			Nper:     360,
+			Pv:       125000,
+			Start:    13,
+			End:      24,
+			Type:     true,
+			CumIpmt:  -11052.3395839,
+			CumPrinc: -927.1534724,
+		},
+		{
+			Rate:     0.09 / 12,
+			Nper:     360,
+			Pv:       125000,
+			Start:    1,
+			End:      12,
+			Type:     true,
+			CumIpmt:  -10201.3328845,
+			CumPrinc: -1778.1601718,
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package testdata
+
+type depreciationTestData struct {
+	Cost     float64
+	Salvage  float64
+	Life     float64
+	Period   float64 // Start period of Vdb
+	End      float64 // Vdb only
+	Factor   float64 // Ddb and Vdb
+	Months   float64 // Db only
+	NoSwitch bool    // Vdb only
+	Result   float64
+}
+
+func TESTGetSlnTestData() []depreciationTestData {
+	return []depreciationTestData{
+		{Cost: 30000, Salvage: 7500, Life: 10, Result: 2250},
+		{Cost: 10000, Salvage: 0, Life: 3, Result: 3333.3333333},
+	}
+}
+
+func TESTGetSydTestData() []depreciationTestData {
+	return []depreciationTestData{
+		{Cost: 30000, Salvage: 7500, Life: 10, Period: 1, Result: 4090.91},
+		{Cost: 30000, Salvage: 7500, Life: 10, Period: 10, Result: 409.09},
+	}
+}
+
+func TESTGetDbTestData() []depreciationTestData {
+	return []depreciationTestData{
+		{Cost: 1000000, Salvage: 100000, Life: 6, Period: 1, Months: 7, Result: 186083.33},
+		{Cost: 1000000, Salvage: 100000, Life: 6, Period: 2, Months: 7, Result: 259639.42},
+		{Cost: 1000000, Salvage: 100000, Life: 6, Period: 3, Months: 7, Result: 176814.44},
+		{Cost: 1000000, Salvage: 100000, Life: 6, Period: 4, Months: 7, Result: 120410.64},
+		{Cost: 1000000, Salvage: 100000, Life: 6, Period: 5, Months: 7, Result: 81999.64},
+		{Cost: 1000000, Salvage: 100000, Life: 6, Period: 6, Months: 7, Result: 55841.76},
+		{Cost: 1000000, Salvage: 100000, Life: 6, Period: 7, Months: 7, Result: 15845.10},
+	}
+}
+
+func TESTGetDdbTestData() []depreciationTestData {
+	return []depreciationTestData{
+		{Cost: 2400, Salvage: 300, Life: 3650, Period: 1, Factor: 2, Result: 1.32},
+		{Cost: 2400, Salvage: 300, Life: 120, Period: 1, Factor: 2, Result: 40},
+		{Cost: 2400, Salvage: 300, Life: 10, Period: 1, Factor: 2, Result: 480},
+		{Cost: 2400, Salvage: 300, Life: 10, Period: 2, Factor: 1.5, Result: 306},
+		{Cost: 2400, Salvage: 300, Life: 10, Period: 10, Factor: 2, Result: 22.12},
+	}
+}
+
+func TESTGetVdbTestData() []depreciationTestData {
+	return []depreciationTestData{
+		{Cost: 2400, Salvage: 300, Life: 3650, Period: 0, End: 1, Factor: 2, Result: 1.32},
+		{Cost: 2400, Salvage: 300, Life: 120, Period: 0, End: 1, Factor: 2, Result: 40},
+		{Cost: 2400, Salvage: 300, Life: 10, Period: 0, End: 1, Factor: 2, Result: 480},
+		{Cost: 2400, Salvage: 300, Life: 120, Period: 6, End: 18, Factor: 2, Result: 396.31},
+		{Cost: 2400, Salvage: 300, Life: 120, Period: 6, End: 18, Factor: 1.5, Result: 311.81},
+		{Cost: 2400, Salvage: 300, Life: 10, Period: 0, End: 0.875, Factor: 1.5, Result: 315},
+		{Cost: 2400, Salvage: 300, Life: 10, Period: 0, End: 10, Factor: 2, Result: 2100},
+		{Cost: 2400, Salvage: 300, Life: 10, Period: 0, End: 10, Factor: 1, Result: 2100},
+		{Cost: 2400, Salvage: 300, Life: 10, Period: 0, End: 10, Factor: 1, NoSwitch: true, Result: 1563.17},
+	}
+}
@@ -0,0 +1,21 @@
+package testdata
+
+type durationTestData struct {
+	Settlement string // "yyyy-mm-dd"
+	Maturity   string // "yyyy-mm-dd"
+	Coupon     float64
+	Yield      float64
+	Frequency  int64
+	Basis      int64
+	Duration   float64
+	MDuration  float64
+}
+
+func TESTGetDurationTestData() []durationTestData {
+	return []durationTestData{
+		{Settlement: "2008-01-01", Maturity: "2016-01-01", Coupon: 0.08, Yield: 0.09, Frequency: 2, Basis: 1, Duration: 5.993775, MDuration: 5.73567},
+		{Settlement: "2008-01-01", Maturity: "2016-01-01", Coupon: 0.08, Yield: 0.09, Frequency: 1, Basis: 1, Duration: 6.1490679, MDuration: 5.6413467},
+		{Settlement: "2010-03-10", Maturity: "2015-12-31", Coupon: 0.04, Yield: 0.05, Frequency: 4, Basis: 0, Duration: 5.1480623, MDuration: 5.084506},
+		{Settlement: "2010-03-10", Maturity: "2015-12-31", Coupon: 0.04, Yield: 0.05, Frequency: 4, Basis: 1, Duration: 5.1508401, MDuration: 5.0872495},
+	}
+}
@@ -0,0 +1,81 @@
+package testdata
+
+type fvScheduleTestData struct {
+	Principal float64
+	Rates     []float64
+	Result    float64
+}
+
+type growthTestData struct {
+	Rate   float64 // Rate per period, Pduration input (Rri result)
+	Nper   float64 // Rri input (Pduration result)
+	Pv     float64
+	Fv     float64
+	Result float64
+}
+
+func TESTGetFvScheduleTestData() []fvScheduleTestData {
+	return []fvScheduleTestData{
+		{
+			Principal: 1,
+			Rates:     []float64{0.09, 0.11, 0.1},
+			Result:    1.33089,
+		},
+		{
+			Principal: 10000,
+			Rates:     []float64{0.01, 0.015, 0.02, 0.025, 0.03},
+			Result:    11039.4815475,
+		},
+		{
+			Principal: 5000,
+			Rates:     []float64{},
+			Result:    5000,
+		},
+	}
+}
+
+func TESTGetRriTestData() []growthTestData {
+	return []growthTestData{
+		{
+			Nper:   96,
+			Pv:     10000,
+			Fv:     11000,
+			Result: 0.0009933,
+		},
+		{
+			Nper:   10,
+			Pv:     1000,
+			Fv:     2000,
+			Result: 0.0717735,
+		},
+		{
+			Nper:   4,
+			Pv:     1000,
+			Fv:     500,
+			Result: -0.1591036,
+		},
+	}
+}
+
+func TESTGetPdurationTestData() []growthTestData {
+	return []growthTestData{
+		{
+			Rate:   0.025,
+			Pv:     2000,
+			Fv:     2200,
+			Result: 3.86,
+		},
+		{
+			Rate:   0.025 / 12,
+			Pv:     1000,
+			Fv:     1200,
+			Result: 87.6,
+		},
+		{
+			Rate:   0.07,
+			Pv:     1000,
+			Fv:     2000,
+			Result: 10.2447684,
+		},
+	}
+}
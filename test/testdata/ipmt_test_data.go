@@ -0,0 +1,77 @@
+package testdata
+
+type ipmtTestData struct {
+	Rate float64
+	Per  int64
+	Nper int64
+	Pv   float64
+	Fv   float64
+	Type bool
+	Ipmt float64
+	Ppmt float64
+}
+
+func TESTGetIpmtTestData() []ipmtTestData {
+	return []ipmtTestData{
+		{
+			Rate: 0.1 / 12,
+			Per:  1,
+			Nper: 36,
+			Pv:   8000,
+			Fv:   0,
+			Type: false,
+			Ipmt: -66.67,
+			Ppmt: -191.47,
+		},
+		{
+			Rate: 0.1,
+			Per:  3,
+			Nper: 3,
+			Pv:   8000,
+			Fv:   0,
+			Type: false,
+			Ipmt: -292.45,
+			Ppmt: -2924.47,
+		},
+		{
+			Rate: 0.1 / 12,
+			Per:  1,
+			Nper: 24,
+			Pv:   2000,
+			Fv:   0,
+			Type: false,
+			Ipmt: -16.67,
+			Ppmt: -75.62,
+		},
+		{
+			Rate: 0.08,
+			Per:  10,
+			Nper: 10,
+			Pv:   200000,
+			Fv:   0,
+			Type: false,
+			Ipmt: -2207.84,
+			Ppmt: -27598.05,
+		},
+		{
+			Rate: 0.05 / 12,
+			Per:  7,
+			Nper: 60,
+			Pv:   30000,
+			Fv:   0,
+			Type: true,
+			Ipmt: -113.3836234,
+			Ppmt: -450.4042697,
+		},
+		{
+			Rate: 0.1,
+			Per:  3,
+			Nper: 3,
+			Pv:   8000,
+			Fv:   0,
+			Type: true,
+			Ipmt: -265.8610272,
+			Ppmt: -2658.6102719,
+		},
+	}
+}
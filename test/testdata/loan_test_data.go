@@ -0,0 +1,55 @@
+package testdata
+
+type loanTestData struct {
+	Principal              float64
+	Rate                   float64 // Annual
+	Periods                int64   // Months
+	LoanDate               string  // "yyyy-mm-dd"
+	FirstPayment           string  // "yyyy-mm-dd"
+	Payment                float64 // Shpitzer monthly payment
+	TotalInterest          float64 // Shpitzer total interest
+	EqualPrincipalFirst    float64 // SameFromPrincipal first payment
+	EqualPrincipalInterest float64 // SameFromPrincipal total interest
+	EqualPrincipalXirr     float64 // SameFromPrincipal XIRR, loan given at LoanDate
+}
+
+func TESTGetLoanTestData() []loanTestData {
+	return []loanTestData{
+		{
+			Principal:              10000,
+			Rate:                   0.08,
+			Periods:                120,
+			LoanDate:               "2010-05-10",
+			FirstPayment:           "2010-06-10",
+			Payment:                121.33,
+			TotalInterest:          4559.31,
+			EqualPrincipalFirst:    150,
+			EqualPrincipalInterest: 4033.33,
+			EqualPrincipalXirr:     0.08288713,
+		},
+		{
+			Principal:              250000,
+			Rate:                   0.045,
+			Periods:                360,
+			LoanDate:               "2021-01-01",
+			FirstPayment:           "2021-02-01",
+			Payment:                1266.71,
+			TotalInterest:          206016.78,
+			EqualPrincipalFirst:    1631.94,
+			EqualPrincipalInterest: 169218.75,
+			EqualPrincipalXirr:     0.04592142,
+		},
+		{
+			Principal:              100000,
+			Rate:                   0.12345,
+			Periods:                240,
+			LoanDate:               "2000-04-15",
+			FirstPayment:           "2000-05-15",
+			Payment:                1125.23,
+			TotalInterest:          170055.72,
+			EqualPrincipalFirst:    1445.42,
+			EqualPrincipalInterest: 123964.375,
+			EqualPrincipalXirr:     0.13058338,
+		},
+	}
+}
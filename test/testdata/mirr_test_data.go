@@ -0,0 +1,37 @@
+package testdata
+
+type mirrTestData struct {
+	Values       []float64
+	FinanceRate  float64
+	ReinvestRate float64
+	Result       float64
+}
+
+func TESTGetMirrTestData() []mirrTestData {
+	return []mirrTestData{
+		{
+			Values:       []float64{-120000, 39000, 30000, 21000, 37000, 46000},
+			FinanceRate:  0.1,
+			ReinvestRate: 0.12,
+			Result:       0.126094,
+		},
+		{
+			Values:       []float64{-120000, 39000, 30000, 21000},
+			FinanceRate:  0.1,
+			ReinvestRate: 0.12,
+			Result:       -0.048045,
+		},
+		{
+			Values:       []float64{-120000, 39000, 30000, 21000, 37000, 46000},
+			FinanceRate:  0.1,
+			ReinvestRate: 0.14,
+			Result:       0.134759,
+		},
+		{
+			Values:       []float64{-10000, 2000, -1000, 5000, 4000, 3000},
+			FinanceRate:  0.08,
+			ReinvestRate: 0.1,
+			Result:       0.0856968,
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package testdata
+
+type nperTestData struct {
+	Rate   float64
+	Pmt    float64
+	Pv     float64
+	Fv     float64
+	Type   bool
+	Result float64
+}
+
+func TESTGetNperTestData() []nperTestData {
+	return []nperTestData{
+		{
+			Rate:   0.01,
+			Pmt:    -100,
+			Pv:     -1000,
+			Fv:     10000,
+			Type:   true,
+			Result: 59.6738657,
+		},
+		{
+			Rate:   0.01,
+			Pmt:    -100,
+			Pv:     -1000,
+			Fv:     10000,
+			Type:   false,
+			Result: 60.0821229,
+		},
+		{
+			Rate:   0.01,
+			Pmt:    -100,
+			Pv:     -1000,
+			Fv:     0,
+			Type:   false,
+			Result: -9.5785940,
+		},
+		{
+			Rate:   0,
+			Pmt:    -250,
+			Pv:     12000,
+			Fv:     -2000,
+			Type:   false,
+			Result: 40,
+		},
+		{
+			Rate:   0.045 / 12,
+			Pmt:    -1266.71,
+			Pv:     250000,
+			Fv:     0,
+			Type:   false,
+			Result: 360.002,
+		},
+	}
+}
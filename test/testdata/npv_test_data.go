@@ -0,0 +1,32 @@
+package testdata
+
+type npvTestData struct {
+	Rate   float64
+	Values []float64
+	Result float64 // Excel NPV
+}
+
+func TESTGetNpvTestData() []npvTestData {
+	return []npvTestData{
+		{
+			Rate:   0.1,
+			Values: []float64{-10000, 3000, 4200, 6800},
+			Result: 1188.44,
+		},
+		{
+			Rate:   0.08,
+			Values: []float64{8000, 9200, 10000, 12000, 14500},
+			Result: 41922.06,
+		},
+		{
+			Rate:   0.08,
+			Values: []float64{8000, 9200, 10000, 12000, 14500, -9000},
+			Result: 36250.53,
+		},
+		{
+			Rate:   0.05,
+			Values: []float64{-100000, 20000, 30000, 40000, 50000},
+			Result: 20902.0296,
+		},
+	}
+}
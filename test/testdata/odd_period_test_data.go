@@ -0,0 +1,40 @@
+package testdata
+
+type oddPeriodTestData struct {
+	Settlement string  // "yyyy-mm-dd"
+	Maturity   string  // "yyyy-mm-dd"
+	Issue      string  // "yyyy-mm-dd", odd first period only
+	Coupon     string  // "yyyy-mm-dd", first coupon or last interest
+	Rate       float64 // Coupon rate
+	Value      float64 // Yield for price, price for yield
+	Redemption float64
+	Frequency  int64
+	Basis      int64
+	Result     float64
+}
+
+func TESTGetOddfpriceTestData() []oddPeriodTestData {
+	return []oddPeriodTestData{
+		{Settlement: "2008-11-11", Maturity: "2021-03-01", Issue: "2008-10-15", Coupon: "2009-03-01", Rate: 0.0785, Value: 0.0625, Redemption: 100, Frequency: 2, Basis: 1, Result: 113.597717},
+		// Long first period
+		{Settlement: "2008-02-20", Maturity: "2012-03-01", Issue: "2008-01-15", Coupon: "2009-03-01", Rate: 0.06, Value: 0.07, Redemption: 100, Frequency: 2, Basis: 1, Result: 96.395922},
+	}
+}
+
+func TESTGetOddfyieldTestData() []oddPeriodTestData {
+	return []oddPeriodTestData{
+		{Settlement: "2008-11-11", Maturity: "2021-03-01", Issue: "2008-10-15", Coupon: "2009-03-01", Rate: 0.0575, Value: 84.5, Redemption: 100, Frequency: 2, Basis: 0, Result: 0.077245542},
+	}
+}
+
+func TESTGetOddlpriceTestData() []oddPeriodTestData {
+	return []oddPeriodTestData{
+		{Settlement: "2008-02-07", Maturity: "2008-06-15", Coupon: "2007-10-15", Rate: 0.0375, Value: 0.0405, Redemption: 100, Frequency: 2, Basis: 0, Result: 99.87829},
+	}
+}
+
+func TESTGetOddlyieldTestData() []oddPeriodTestData {
+	return []oddPeriodTestData{
+		{Settlement: "2008-04-20", Maturity: "2008-06-15", Coupon: "2007-12-24", Rate: 0.0375, Value: 99.875, Redemption: 100, Frequency: 2, Basis: 0, Result: 0.045192},
+	}
+}
@@ -0,0 +1,61 @@
+package testdata
+
+type rateTestData struct {
+	Nper   int64
+	Pmt    float64
+	Pv     float64
+	Fv     float64
+	Type   bool
+	Guess  float64
+	Result float64
+}
+
+func TESTGetRateTestData() []rateTestData {
+	return []rateTestData{
+		{
+			Nper:   48,
+			Pmt:    -200,
+			Pv:     8000,
+			Fv:     0,
+			Type:   false,
+			Guess:  0.1,
+			Result: 0.0077014725,
+		},
+		{
+			Nper:   360,
+			Pmt:    -1266.71,
+			Pv:     250000,
+			Fv:     0,
+			Type:   false,
+			Guess:  0.1,
+			Result: 0.0037499816,
+		},
+		{
+			Nper:   10,
+			Pmt:    -1000,
+			Pv:     0,
+			Fv:     15000,
+			Type:   true,
+			Guess:  0.1,
+			Result: 0.0725674021,
+		},
+		{
+			Nper:   36,
+			Pmt:    -300,
+			Pv:     10000,
+			Fv:     -1000,
+			Type:   false,
+			Guess:  0.01,
+			Result: 0.008541032,
+		},
+		{
+			Nper:   12,
+			Pmt:    -1000,
+			Pv:     -5000,
+			Fv:     20000,
+			Type:   false,
+			Guess:  0,
+			Result: 0.0216327529,
+		},
+	}
+}